@@ -0,0 +1,10 @@
+data "kubernetes_resource_table" "pods" {
+  api_version    = "v1"
+  kind           = "Pod"
+  all_namespaces = true
+  wide           = true
+}
+
+output "not_running" {
+  value = [for row in data.kubernetes_resource_table.pods.rows : "${row.namespace}/${row.name} on ${row.cells["Node"]}" if row.cells["Status"] != "Running"]
+}
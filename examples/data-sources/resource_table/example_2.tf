@@ -0,0 +1,12 @@
+data "kubernetes_resource_table" "certificates" {
+  api_version = "cert-manager.io/v1"
+  kind        = "Certificate"
+  namespace   = "ingress"
+}
+
+check "certificates_ready" {
+  assert {
+    condition     = alltrue([for row in data.kubernetes_resource_table.certificates.rows : row.cells["Ready"] == "True"])
+    error_message = "All certificates must be ready."
+  }
+}
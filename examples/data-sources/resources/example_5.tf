@@ -0,0 +1,12 @@
+data "kubernetes_resources" "pods" {
+  api_version    = "v1"
+  kind           = "Pod"
+  all_namespaces = true
+  metadata_only  = true
+  page_size      = 200
+  label_selector = "app.kubernetes.io/managed-by=Helm"
+}
+
+output "pods" {
+  value = [for pod in data.kubernetes_resources.pods.objects : "${pod.metadata.namespace}/${pod.metadata.name}"]
+}
@@ -0,0 +1,13 @@
+data "kubernetes_resources" "ready_nodes" {
+  api_version = "v1"
+  kind        = "Node"
+  filter      = "self.status.conditions.exists(c, c.type == 'Ready' && c.status == 'True')"
+  select = {
+    name        = "{.metadata.name}"
+    internal_ip = "{.status.addresses[?(@.type==\"InternalIP\")].address}"
+  }
+}
+
+output "internal_ips" {
+  value = { for node in data.kubernetes_resources.ready_nodes.items : node.name => node.internal_ip }
+}
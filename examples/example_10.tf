@@ -0,0 +1,6 @@
+provider "kubernetes" {
+  config_path = "~/.kube/config"
+
+  apply_mode      = "server_side"
+  force_conflicts = false
+}
@@ -0,0 +1,5 @@
+resource "kubernetes_persistent_volume_claim_v1" "data" {
+  // omit the resource config
+
+  deletion_policy = "retain"
+}
@@ -0,0 +1,12 @@
+resource "kubernetes_gateway_class_v1" "example" {
+  metadata {
+    name = "example"
+  }
+
+  spec {
+    controller_name = "example.net/gateway-controller"
+    description     = "Gateways managed by the example controller"
+  }
+
+  wait_for_accepted = true
+}
@@ -0,0 +1,49 @@
+resource "kubernetes_gateway_v1" "example" {
+  metadata {
+    name      = "example"
+    namespace = "infra"
+  }
+
+  spec {
+    gateway_class_name = "example"
+
+    listener {
+      name     = "http"
+      port     = 80
+      protocol = "HTTP"
+
+      allowed_routes {
+        namespaces {
+          from = "All"
+        }
+      }
+    }
+
+    listener {
+      name     = "https"
+      hostname = "*.example.com"
+      port     = 443
+      protocol = "HTTPS"
+
+      tls {
+        certificate_ref {
+          name = "example-com-tls"
+        }
+      }
+
+      allowed_routes {
+        namespaces {
+          from = "Selector"
+
+          selector {
+            match_labels = {
+              gateway-access = "true"
+            }
+          }
+        }
+      }
+    }
+  }
+
+  wait_for_accepted = true
+}
@@ -0,0 +1,38 @@
+resource "kubernetes_grpc_route_v1" "example" {
+  metadata {
+    name      = "greeter"
+    namespace = "apps"
+  }
+
+  spec {
+    hostnames = ["grpc.example.com"]
+
+    parent_ref {
+      name      = "example"
+      namespace = "infra"
+    }
+
+    rule {
+      match {
+        method {
+          service = "helloworld.Greeter"
+          method  = "SayHello"
+        }
+      }
+
+      match {
+        header {
+          name  = "x-canary"
+          value = "true"
+        }
+      }
+
+      backend_ref {
+        name = "greeter"
+        port = 50051
+      }
+    }
+  }
+
+  wait_for_accepted = true
+}
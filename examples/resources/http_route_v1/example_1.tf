@@ -0,0 +1,57 @@
+resource "kubernetes_http_route_v1" "example" {
+  metadata {
+    name      = "example"
+    namespace = "apps"
+  }
+
+  spec {
+    hostnames = ["www.example.com"]
+
+    parent_ref {
+      name         = "example"
+      namespace    = "infra"
+      section_name = "https"
+    }
+
+    rule {
+      match {
+        path {
+          type  = "PathPrefix"
+          value = "/api"
+        }
+      }
+
+      filter {
+        type = "URLRewrite"
+
+        url_rewrite {
+          path {
+            type                 = "ReplacePrefixMatch"
+            replace_prefix_match = "/"
+          }
+        }
+      }
+
+      backend_ref {
+        name   = "api-v1"
+        port   = 8080
+        weight = 90
+      }
+
+      backend_ref {
+        name   = "api-v2"
+        port   = 8080
+        weight = 10
+      }
+    }
+
+    rule {
+      backend_ref {
+        name = "web"
+        port = 80
+      }
+    }
+  }
+
+  wait_for_accepted = true
+}
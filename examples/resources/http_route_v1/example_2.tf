@@ -0,0 +1,24 @@
+resource "kubernetes_http_route_v1" "redirect" {
+  metadata {
+    name      = "https-redirect"
+    namespace = "infra"
+  }
+
+  spec {
+    parent_ref {
+      name         = "example"
+      section_name = "http"
+    }
+
+    rule {
+      filter {
+        type = "RequestRedirect"
+
+        request_redirect {
+          scheme      = "https"
+          status_code = 301
+        }
+      }
+    }
+  }
+}
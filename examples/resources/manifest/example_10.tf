@@ -0,0 +1,12 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    // ...
+  }
+
+  delete_propagation           = "Foreground"
+  remove_finalizers_on_timeout = true
+
+  timeouts {
+    delete = "5m"
+  }
+}
@@ -0,0 +1,8 @@
+provider "kubernetes" {
+  config_path = "~/.kube/config"
+
+  schema_sources = [
+    "${path.module}/crds/*.yaml",
+    "${path.module}/openapi-v3/",
+  ]
+}
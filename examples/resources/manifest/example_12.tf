@@ -0,0 +1,7 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    // ...
+  }
+
+  drift_policy = "warn"
+}
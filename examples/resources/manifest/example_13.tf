@@ -0,0 +1,10 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    // ...
+  }
+
+  ignore_fields = [
+    "spec.replicas",
+    "webhooks[0].clientConfig.caBundle",
+  ]
+}
@@ -0,0 +1,13 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    // ...
+  }
+
+  field_manager {
+    # take over the fields set by kubectl and Helm before this resource was imported
+    adopt_from_managers = ["kubectl-client-side-apply", "helm"]
+
+    # leave the object in the cluster when the resource is destroyed
+    release_on_destroy = true
+  }
+}
@@ -0,0 +1,9 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    // ...
+  }
+
+  wait {
+    expression = "self.status.readyReplicas >= self.spec.replicas && self.status.observedGeneration == self.metadata.generation"
+  }
+}
@@ -0,0 +1,21 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    // ...
+  }
+
+  wait {
+    condition {
+      type   = "Complete"
+      status = "True"
+    }
+
+    failure_condition {
+      type   = "Failed"
+      status = "True"
+    }
+
+    failure_fields = {
+      "status.failed" = "^[1-9]"
+    }
+  }
+}
@@ -0,0 +1,3 @@
+resource "kubernetes_manifests" "cert_manager" {
+  content = file("${path.module}/cert-manager.yaml")
+}
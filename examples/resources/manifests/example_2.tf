@@ -0,0 +1,9 @@
+resource "kubernetes_manifests" "bundle" {
+  objects = provider::kubernetes::manifest_decode_multi(templatefile("${path.module}/bundle.yaml.tftpl", {
+    namespace = "monitoring"
+  }))
+
+  field_manager {
+    force_conflicts = true
+  }
+}
@@ -0,0 +1,9 @@
+resource "kubernetes_manifests" "ingress_nginx" {
+  content = file("${path.module}/ingress-nginx.yaml")
+
+  applyset {
+    name      = "ingress-nginx"
+    namespace = "default"
+    tooling   = "kubectl/v1.28"
+  }
+}
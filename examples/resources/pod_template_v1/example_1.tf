@@ -0,0 +1,33 @@
+resource "kubernetes_pod_template_v1" "example" {
+  metadata {
+    name = "terraform-example"
+    labels = {
+      controller = "batch-runner"
+    }
+  }
+
+  template {
+    metadata {
+      labels = {
+        app = "worker"
+      }
+    }
+
+    spec {
+      container {
+        image   = "busybox:1.36"
+        name    = "worker"
+        command = ["sh", "-c", "echo processing && sleep 30"]
+
+        resources {
+          requests = {
+            cpu    = "100m"
+            memory = "64Mi"
+          }
+        }
+      }
+
+      restart_policy = "Never"
+    }
+  }
+}
@@ -0,0 +1,18 @@
+resource "kubernetes_reference_grant_v1beta1" "example" {
+  metadata {
+    name      = "allow-apps-routes"
+    namespace = "backends"
+  }
+
+  spec {
+    from {
+      group     = "gateway.networking.k8s.io"
+      kind      = "HTTPRoute"
+      namespace = "apps"
+    }
+
+    to {
+      kind = "Service"
+    }
+  }
+}
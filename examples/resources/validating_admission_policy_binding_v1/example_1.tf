@@ -0,0 +1,23 @@
+resource "kubernetes_validating_admission_policy_binding_v1" "example" {
+  metadata {
+    name = "replica-limit-test.terraform.io"
+  }
+
+  spec {
+    policy_name        = kubernetes_validating_admission_policy_v1.example.metadata[0].name
+    validation_actions = ["Deny"]
+
+    param_ref {
+      name      = "replica-limit-test"
+      namespace = "default"
+    }
+
+    match_resources {
+      namespace_selector {
+        match_labels = {
+          environment = "test"
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,34 @@
+resource "kubernetes_validating_admission_policy_v1" "example" {
+  metadata {
+    name = "replica-limit.terraform.io"
+  }
+
+  spec {
+    failure_policy = "Fail"
+
+    param_kind {
+      api_version = "v1"
+      kind        = "ConfigMap"
+    }
+
+    match_constraints {
+      resource_rule {
+        api_groups   = ["apps"]
+        api_versions = ["v1"]
+        operations   = ["CREATE", "UPDATE"]
+        resources    = ["deployments"]
+      }
+    }
+
+    variable {
+      name       = "max_replicas"
+      expression = "int(params.data.maxReplicas)"
+    }
+
+    validation {
+      expression         = "object.spec.replicas <= variables.max_replicas"
+      message_expression = "'replicas must be no greater than ' + string(variables.max_replicas)"
+      reason             = "Invalid"
+    }
+  }
+}
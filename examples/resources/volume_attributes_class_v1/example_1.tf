@@ -0,0 +1,30 @@
+resource "kubernetes_volume_attributes_class_v1" "gold" {
+  metadata {
+    name = "gold"
+  }
+
+  driver_name = "ebs.csi.aws.com"
+
+  parameters = {
+    iops       = "16000"
+    throughput = "1000"
+  }
+}
+
+resource "kubernetes_persistent_volume_claim_v1" "example" {
+  metadata {
+    name = "example"
+  }
+
+  spec {
+    access_modes                 = ["ReadWriteOnce"]
+    storage_class_name           = "ebs-sc"
+    volume_attributes_class_name = kubernetes_volume_attributes_class_v1.gold.metadata.0.name
+
+    resources {
+      requests = {
+        storage = "100Gi"
+      }
+    }
+  }
+}
@@ -0,0 +1,12 @@
+resource "kubernetes_volume_snapshot_class_v1" "example" {
+  metadata {
+    name = "example"
+  }
+
+  driver                   = "hostpath.csi.k8s.io"
+  snapshot_deletion_policy = "Delete"
+
+  parameters = {
+    type = "full"
+  }
+}
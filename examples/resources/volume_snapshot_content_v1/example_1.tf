@@ -0,0 +1,34 @@
+resource "kubernetes_volume_snapshot_content_v1" "example" {
+  metadata {
+    name = "imported-snapshot"
+  }
+
+  spec {
+    deletion_policy = "Retain"
+    driver          = "hostpath.csi.k8s.io"
+
+    source {
+      snapshot_handle = "7bdd0de3-aaeb-11e8-9aae-0242ac110002"
+    }
+
+    volume_snapshot_ref {
+      name      = "imported-snapshot"
+      namespace = "default"
+    }
+  }
+}
+
+resource "kubernetes_volume_snapshot_v1" "example" {
+  metadata {
+    name      = "imported-snapshot"
+    namespace = "default"
+  }
+
+  spec {
+    source {
+      volume_snapshot_content_name = kubernetes_volume_snapshot_content_v1.example.metadata.0.name
+    }
+  }
+
+  wait_for_ready_to_use = true
+}
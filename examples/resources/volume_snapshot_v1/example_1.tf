@@ -0,0 +1,58 @@
+resource "kubernetes_persistent_volume_claim_v1" "data" {
+  metadata {
+    name      = "data"
+    namespace = "default"
+  }
+
+  spec {
+    access_modes       = ["ReadWriteOnce"]
+    storage_class_name = "csi-hostpath-sc"
+
+    resources {
+      requests = {
+        storage = "1Gi"
+      }
+    }
+  }
+}
+
+resource "kubernetes_volume_snapshot_v1" "example" {
+  metadata {
+    name      = "data-backup"
+    namespace = "default"
+  }
+
+  spec {
+    source {
+      persistent_volume_claim_name = kubernetes_persistent_volume_claim_v1.data.metadata.0.name
+    }
+
+    volume_snapshot_class_name = "csi-hostpath-snapclass"
+  }
+
+  wait_for_ready_to_use = true
+}
+
+resource "kubernetes_persistent_volume_claim_v1" "restored" {
+  metadata {
+    name      = "data-restored"
+    namespace = "default"
+  }
+
+  spec {
+    access_modes       = ["ReadWriteOnce"]
+    storage_class_name = "csi-hostpath-sc"
+
+    resources {
+      requests = {
+        storage = kubernetes_volume_snapshot_v1.example.status.0.restore_size
+      }
+    }
+
+    data_source {
+      api_group = "snapshot.storage.k8s.io"
+      kind      = "VolumeSnapshot"
+      name      = kubernetes_volume_snapshot_v1.example.metadata.0.name
+    }
+  }
+}
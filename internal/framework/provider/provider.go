@@ -55,6 +55,12 @@ type KubernetesProviderModel struct {
 	IgnoreAnnotations types.List `tfsdk:"ignore_annotations"`
 	IgnoreLabels      types.List `tfsdk:"ignore_labels"`
 
+	ApplyMode      types.String `tfsdk:"apply_mode"`
+	ForceConflicts types.Bool   `tfsdk:"force_conflicts"`
+
+	CacheDir      types.String `tfsdk:"cache_dir"`
+	SchemaSources types.List   `tfsdk:"schema_sources"`
+
 	Exec []struct {
 		APIVersion types.String            `tfsdk:"api_version"`
 		Command    types.String            `tfsdk:"command"`
@@ -146,6 +152,23 @@ func (p *KubernetesProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Description: "List of Kubernetes metadata labels to ignore across all resources handled by this provider for situations where external systems are managing certain resource labels. Each item is a regular expression.",
 				Optional:    true,
 			},
+			"apply_mode": schema.StringAttribute{
+				Description: "How `kubernetes_config_map_v1`, `kubernetes_daemon_set_v1`, `kubernetes_deployment_v1`, `kubernetes_pod_template_v1`, `kubernetes_replica_set_v1`, `kubernetes_service_v1` and `kubernetes_stateful_set_v1` are created and updated. With \"server_side\" the objects are applied with server-side apply, so that fields set by other field managers are left untouched. Other resources ignore it. Defaults to \"client_side\".",
+				Optional:    true,
+			},
+			"force_conflicts": schema.BoolAttribute{
+				Description: "Force the server-side apply of typed resources when another field manager owns a field Terraform sets. Only used when `apply_mode` is \"server_side\".",
+				Optional:    true,
+			},
+			"cache_dir": schema.StringAttribute{
+				Description: "Path to a directory in which to persist discovery data and OpenAPI documents between runs, using the same layout as kubectl's `~/.kube/cache`. Can be set with KUBE_CACHE_DIR environment variable.",
+				Optional:    true,
+			},
+			"schema_sources": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "List of files, directories or glob patterns of CustomResourceDefinition manifests and OpenAPI v3 documents used to resolve resource types before consulting the API server. Allows planning `kubernetes_manifest` resources whose types are not yet known to the cluster.",
+				Optional:    true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"exec": schema.ListNestedBlock{
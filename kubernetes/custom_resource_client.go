@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+// customResourceKind identifies a resource whose Go types are not part of the version of
+// k8s.io/api the provider is built with: resources defined by a CRD, such as the Gateway API,
+// and built-in resources newer than k8s.io/api, such as VolumeAttributesClass. These resources
+// are managed as unstructured objects through the dynamic client.
+type customResourceKind struct {
+	group      string
+	version    string
+	resource   string
+	kind       string
+	namespaced bool
+}
+
+func (k customResourceKind) groupVersion() string {
+	return k.group + "/" + k.version
+}
+
+func (k customResourceKind) groupVersionKind() apimachineryschema.GroupVersionKind {
+	return apimachineryschema.GroupVersionKind{Group: k.group, Version: k.version, Kind: k.kind}
+}
+
+func (k customResourceKind) client(meta interface{}, namespace string) (dynamic.ResourceInterface, error) {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	gvr := apimachineryschema.GroupVersionResource{Group: k.group, Version: k.version, Resource: k.resource}
+	if k.namespaced {
+		return dc.Resource(gvr).Namespace(namespace), nil
+	}
+	return dc.Resource(gvr), nil
+}
+
+func (k customResourceKind) idParts(id string) (string, string, error) {
+	if k.namespaced {
+		return idParts(id)
+	}
+	return "", id, nil
+}
+
+// createCustomResourceObject creates the object from the metadata of the resource and the given
+// top level fields, such as "spec", and sets the ID of the resource.
+func createCustomResourceObject(ctx context.Context, d *schema.ResourceData, meta interface{}, k customResourceKind, fields map[string]interface{}) error {
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+
+	obj := &unstructured.Unstructured{Object: fields}
+	obj.SetGroupVersionKind(k.groupVersionKind())
+	obj.SetName(metadata.Name)
+	obj.SetGenerateName(metadata.GenerateName)
+	obj.SetLabels(metadata.Labels)
+	obj.SetAnnotations(metadata.Annotations)
+	if k.namespaced {
+		obj.SetNamespace(metadata.Namespace)
+	}
+
+	client, err := k.client(meta, metadata.Namespace)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating new %s: %#v", k.kind, obj)
+	out, err := client.Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("Failed to create %s: %s", k.kind, err)
+	}
+	log.Printf("[INFO] Submitted new %s: %#v", k.kind, out)
+
+	if k.namespaced {
+		d.SetId(buildId(metav1.ObjectMeta{Namespace: out.GetNamespace(), Name: out.GetName()}))
+	} else {
+		d.SetId(out.GetName())
+	}
+	return nil
+}
+
+// readCustomResourceObject reads the object and sets its metadata. It returns nil when the object does not exist.
+func readCustomResourceObject(ctx context.Context, d *schema.ResourceData, meta interface{}, k customResourceKind) (*unstructured.Unstructured, error) {
+	namespace, name, err := k.idParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	client, err := k.client(meta, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Reading %s %s", k.kind, name)
+	obj, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[INFO] %s %s not found", k.kind, name)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Failed to read %s '%s' because: %s", k.kind, d.Id(), err)
+	}
+	log.Printf("[INFO] Received %s: %#v", k.kind, obj)
+
+	metadata := metav1.ObjectMeta{}
+	if m, ok := obj.Object["metadata"].(map[string]interface{}); ok {
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(m, &metadata)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = d.Set("metadata", flattenMetadata(metadata, d, meta))
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// patchCustomResourceSpec returns the operation replacing the spec of the object when it has changed
+func patchCustomResourceSpec(d *schema.ResourceData, spec map[string]interface{}) PatchOperations {
+	if !d.HasChange("spec") {
+		return nil
+	}
+	return PatchOperations{
+		&ReplaceOperation{
+			Path:  "/spec",
+			Value: spec,
+		},
+	}
+}
+
+// updateCustomResourceObject patches the metadata of the object along with the given operations
+func updateCustomResourceObject(ctx context.Context, d *schema.ResourceData, meta interface{}, k customResourceKind, ops PatchOperations) error {
+	namespace, name, err := k.idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	client, err := k.client(meta, namespace)
+	if err != nil {
+		return err
+	}
+
+	ops = append(patchMetadata("metadata.0.", "/metadata/", d), ops...)
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating %s %q: %v", k.kind, name, string(data))
+	out, err := client.Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("Failed to update %s: %s", k.kind, err)
+	}
+	log.Printf("[INFO] Submitted updated %s: %#v", k.kind, out)
+	return nil
+}
+
+func deleteCustomResourceObject(ctx context.Context, d *schema.ResourceData, meta interface{}, k customResourceKind) error {
+	namespace, name, err := k.idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	client, err := k.client(meta, namespace)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting %s: %#v", k.kind, name)
+	err = client.Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Failed to delete %s %s because: %s", k.kind, d.Id(), err)
+	}
+
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		_, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return retry.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("%s (%s) still exists", k.kind, d.Id())
+		return retry.RetryableError(e)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] %s %s deleted", k.kind, name)
+	return nil
+}
+
+// waitForCustomResource polls the object until ready returns no error, which is reported when
+// the timeout is reached. state describes what is waited for, e.g. "accepted".
+func waitForCustomResource(ctx context.Context, d *schema.ResourceData, meta interface{}, k customResourceKind, timeout, state string, ready func(*unstructured.Unstructured) error) error {
+	namespace, name, err := k.idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	client, err := k.client(meta, namespace)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Waiting for %s %s to be %s", k.kind, d.Id(), state)
+	return retry.RetryContext(ctx, d.Timeout(timeout), func() *retry.RetryError {
+		obj, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			// NOTE it is possible in some HA apiserver setups that are eventually consistent
+			// that we could get a 404 when doing a Get immediately after a Create
+			if errors.IsNotFound(err) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		if err := ready(obj); err != nil {
+			log.Printf("[INFO] %s %s is not %s yet: %s", k.kind, d.Id(), state, err)
+			return retry.RetryableError(fmt.Errorf("%s %s is not %s yet: %s", k.kind, d.Id(), state, err))
+		}
+		return nil
+	})
+}
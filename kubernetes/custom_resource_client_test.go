@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testAccCheckKubernetesCustomResourceObjectDestroy(k customResourceKind, resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.TODO()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			namespace, name, err := k.idParts(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			client, err := k.client(testAccProvider.Meta(), namespace)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.Get(ctx, name, metav1.GetOptions{})
+			if err == nil {
+				return fmt.Errorf("%s still exists: %s", k.kind, rs.Primary.ID)
+			}
+			if !errors.IsNotFound(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKubernetesCustomResourceObjectExists(k customResourceKind, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		namespace, name, err := k.idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, err := k.client(testAccProvider.Meta(), namespace)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Get(context.TODO(), name, metav1.GetOptions{})
+		return err
+	}
+}
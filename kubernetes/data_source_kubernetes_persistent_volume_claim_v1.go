@@ -35,6 +35,52 @@ func dataSourceKubernetesPersistentVolumeClaimV1() *schema.Resource {
 							},
 							Set: schema.HashString,
 						},
+						"data_source": {
+							Type:        schema.TypeList,
+							Description: "The object the volume was populated from, such as a VolumeSnapshot or another PersistentVolumeClaim.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"api_group": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"kind": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"data_source_ref": {
+							Type:        schema.TypeList,
+							Description: "The object the volume was populated from, which can be any object for which a volume populator is installed.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"api_group": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"kind": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"namespace": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"resources": {
 							Type:        schema.TypeList,
 							Description: "A list of the minimum resources the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources",
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// The Gateway API is made of CRDs which are not part of k8s.io/api.
+const gatewayAPIGroup = "gateway.networking.k8s.io"
+
+var (
+	gatewayClassV1Kind        = customResourceKind{group: gatewayAPIGroup, version: "v1", resource: "gatewayclasses", kind: "GatewayClass"}
+	gatewayV1Kind             = customResourceKind{group: gatewayAPIGroup, version: "v1", resource: "gateways", kind: "Gateway", namespaced: true}
+	httpRouteV1Kind           = customResourceKind{group: gatewayAPIGroup, version: "v1", resource: "httproutes", kind: "HTTPRoute", namespaced: true}
+	grpcRouteV1Kind           = customResourceKind{group: gatewayAPIGroup, version: "v1", resource: "grpcroutes", kind: "GRPCRoute", namespaced: true}
+	referenceGrantV1beta1Kind = customResourceKind{group: gatewayAPIGroup, version: "v1beta1", resource: "referencegrants", kind: "ReferenceGrant", namespaced: true}
+)
+
+// gatewayAPIConditionsTrue checks that each of the given condition types is True and
+// was observed for the given generation of the object.
+func gatewayAPIConditionsTrue(conditions []interface{}, generation int64, conditionTypes ...string) error {
+	for _, t := range conditionTypes {
+		var condition map[string]interface{}
+		for _, c := range conditions {
+			if m, ok := c.(map[string]interface{}); ok && m["type"] == t {
+				condition = m
+				break
+			}
+		}
+		if condition == nil {
+			return fmt.Errorf("condition %s is not reported", t)
+		}
+		if observed, ok := condition["observedGeneration"].(int64); ok && observed < generation {
+			return fmt.Errorf("condition %s was reported for an older generation", t)
+		}
+		if condition["status"] != string(metav1.ConditionTrue) {
+			return fmt.Errorf("condition %s is %v: %v: %v", t, condition["status"], condition["reason"], condition["message"])
+		}
+	}
+	return nil
+}
+
+// routeParentsAccepted checks that every parent of a route has accepted it and resolved its references
+func routeParentsAccepted(obj *unstructured.Unstructured) error {
+	parents, _, err := unstructured.NestedSlice(obj.Object, "status", "parents")
+	if err != nil {
+		return err
+	}
+	if len(parents) == 0 {
+		return fmt.Errorf("no parent has reported a status")
+	}
+	for _, p := range parents {
+		parent, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditions, _, _ := unstructured.NestedSlice(parent, "conditions")
+		name, _, _ := unstructured.NestedString(parent, "parentRef", "name")
+		err := gatewayAPIConditionsTrue(conditions, obj.GetGeneration(), "Accepted", "ResolvedRefs")
+		if err != nil {
+			return fmt.Errorf("parent %s: %s", name, err)
+		}
+	}
+	return nil
+}
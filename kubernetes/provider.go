@@ -213,6 +213,28 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "List of Kubernetes metadata labels to ignore across all resources handled by this provider for situations where external systems are managing certain resource labels. Each item is a regular expression.",
 			},
+			"apply_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "How `kubernetes_config_map_v1`, `kubernetes_daemon_set_v1`, `kubernetes_deployment_v1`, `kubernetes_pod_template_v1`, `kubernetes_replica_set_v1`, `kubernetes_service_v1` and `kubernetes_stateful_set_v1` are created and updated. With \"server_side\" the objects are applied with server-side apply, so that fields set by other field managers are left untouched. Other resources ignore it. Defaults to \"client_side\".",
+				ValidateFunc: validation.StringInSlice([]string{applyModeClientSide, applyModeServerSide}, false),
+			},
+			"force_conflicts": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Force the server-side apply of typed resources when another field manager owns a field Terraform sets. Only used when `apply_mode` is \"server_side\".",
+			},
+			"cache_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to a directory in which to persist discovery data and OpenAPI documents between runs, using the same layout as kubectl's `~/.kube/cache`. Can be set with KUBE_CACHE_DIR environment variable.",
+			},
+			"schema_sources": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of files, directories or glob patterns of CustomResourceDefinition manifests and OpenAPI v3 documents used to resolve resource types before consulting the API server. Allows planning `kubernetes_manifest` resources whose types are not yet known to the cluster.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -266,6 +288,7 @@ func Provider() *schema.Provider {
 			"kubernetes_secret_v1":                  resourceKubernetesSecretV1(),
 			"kubernetes_pod":                        resourceKubernetesPodV1(),
 			"kubernetes_pod_v1":                     resourceKubernetesPodV1(),
+			"kubernetes_pod_template_v1":            resourceKubernetesPodTemplateV1(),
 			"kubernetes_endpoints":                  resourceKubernetesEndpointsV1(),
 			"kubernetes_endpoints_v1":               resourceKubernetesEndpointsV1(),
 			"kubernetes_endpoint_slice_v1":          resourceKubernetesEndpointSliceV1(),
@@ -293,6 +316,7 @@ func Provider() *schema.Provider {
 			"kubernetes_daemon_set_v1":   resourceKubernetesDaemonSetV1(),
 			"kubernetes_stateful_set":    resourceKubernetesStatefulSetV1(),
 			"kubernetes_stateful_set_v1": resourceKubernetesStatefulSetV1(),
+			"kubernetes_replica_set_v1":  resourceKubernetesReplicaSetV1(),
 
 			// batch
 			"kubernetes_job":         resourceKubernetesJobV1(),
@@ -328,6 +352,13 @@ func Provider() *schema.Provider {
 			"kubernetes_network_policy":    resourceKubernetesNetworkPolicyV1(),
 			"kubernetes_network_policy_v1": resourceKubernetesNetworkPolicyV1(),
 
+			// gateway api
+			"kubernetes_gateway_v1":              resourceKubernetesGatewayV1(),
+			"kubernetes_gateway_class_v1":        resourceKubernetesGatewayClassV1(),
+			"kubernetes_http_route_v1":           resourceKubernetesHTTPRouteV1(),
+			"kubernetes_grpc_route_v1":           resourceKubernetesGRPCRouteV1(),
+			"kubernetes_reference_grant_v1beta1": resourceKubernetesReferenceGrantV1beta1(),
+
 			// policy
 			"kubernetes_pod_disruption_budget":       resourceKubernetesPodDisruptionBudget(),
 			"kubernetes_pod_disruption_budget_v1":    resourceKubernetesPodDisruptionBudgetV1(),
@@ -339,16 +370,22 @@ func Provider() *schema.Provider {
 			"kubernetes_priority_class_v1": resourceKubernetesPriorityClassV1(),
 
 			// admission control
-			"kubernetes_validating_webhook_configuration":    resourceKubernetesValidatingWebhookConfigurationV1Beta1(),
-			"kubernetes_validating_webhook_configuration_v1": resourceKubernetesValidatingWebhookConfigurationV1(),
-			"kubernetes_mutating_webhook_configuration":      resourceKubernetesMutatingWebhookConfiguration(),
-			"kubernetes_mutating_webhook_configuration_v1":   resourceKubernetesMutatingWebhookConfigurationV1(),
+			"kubernetes_validating_webhook_configuration":       resourceKubernetesValidatingWebhookConfigurationV1Beta1(),
+			"kubernetes_validating_webhook_configuration_v1":    resourceKubernetesValidatingWebhookConfigurationV1(),
+			"kubernetes_mutating_webhook_configuration":         resourceKubernetesMutatingWebhookConfiguration(),
+			"kubernetes_mutating_webhook_configuration_v1":      resourceKubernetesMutatingWebhookConfigurationV1(),
+			"kubernetes_validating_admission_policy_v1":         resourceKubernetesValidatingAdmissionPolicyV1(),
+			"kubernetes_validating_admission_policy_binding_v1": resourceKubernetesValidatingAdmissionPolicyBindingV1(),
 
 			// storage
-			"kubernetes_storage_class":    resourceKubernetesStorageClassV1(),
-			"kubernetes_storage_class_v1": resourceKubernetesStorageClassV1(),
-			"kubernetes_csi_driver":       resourceKubernetesCSIDriverV1Beta1(),
-			"kubernetes_csi_driver_v1":    resourceKubernetesCSIDriverV1(),
+			"kubernetes_storage_class":              resourceKubernetesStorageClassV1(),
+			"kubernetes_storage_class_v1":           resourceKubernetesStorageClassV1(),
+			"kubernetes_csi_driver":                 resourceKubernetesCSIDriverV1Beta1(),
+			"kubernetes_csi_driver_v1":              resourceKubernetesCSIDriverV1(),
+			"kubernetes_volume_snapshot_class_v1":   resourceKubernetesVolumeSnapshotClassV1(),
+			"kubernetes_volume_snapshot_v1":         resourceKubernetesVolumeSnapshotV1(),
+			"kubernetes_volume_snapshot_content_v1": resourceKubernetesVolumeSnapshotContentV1(),
+			"kubernetes_volume_attributes_class_v1": resourceKubernetesVolumeAttributesClassV1(),
 
 			// provider helper resources
 			"kubernetes_labels":      resourceKubernetesLabels(),
@@ -362,6 +399,12 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	for name, r := range p.ResourcesMap {
+		if !deletionPolicyExcludedResources[name] {
+			withDeletionPolicy(name, r)
+		}
+	}
+
 	p.ConfigureProvider = func(ctx context.Context, req schema.ConfigureProviderRequest, res *schema.ConfigureProviderResponse) {
 		if req.DeferralAllowed && !req.ResourceData.GetRawConfig().IsWhollyKnown() {
 			res.Deferred = &schema.Deferred{
@@ -392,6 +435,9 @@ type providerMetadata struct {
 
 	IgnoreAnnotations []string
 	IgnoreLabels      []string
+
+	ApplyMode      string
+	ForceConflicts bool
 }
 
 func (k providerMetadata) MainClientset() (*kubernetes.Clientset, error) {
@@ -492,6 +538,8 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		aggregatorClientset: nil,
 		IgnoreAnnotations:   ignoreAnnotations,
 		IgnoreLabels:        ignoreLabels,
+		ApplyMode:           d.Get("apply_mode").(string),
+		ForceConflicts:      d.Get("force_conflicts").(bool),
 	}
 	return m, diag.Diagnostics{}
 }
@@ -358,6 +358,22 @@ func skipIfUnsupportedSecurityContextRunAsGroup(t *testing.T) {
 	skipIfClusterVersionLessThan(t, "1.14.0")
 }
 
+func skipIfCRDNotInstalled(t *testing.T, kind customResourceKind) {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resources, err := conn.Discovery().ServerResourcesForGroupVersion(kind.groupVersion())
+	if err == nil {
+		for _, r := range resources.APIResources {
+			if r.Name == kind.resource {
+				return
+			}
+		}
+	}
+	t.Skipf("The CRD of %s must be installed and serve %s for this test to run - skipping", kind.kind, kind.groupVersion())
+}
+
 func isRunningInMinikube() (bool, error) {
 	node, err := getFirstNode()
 	if err != nil {
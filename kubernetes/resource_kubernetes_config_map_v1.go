@@ -88,7 +88,12 @@ func resourceKubernetesConfigMapV1Create(ctx context.Context, d *schema.Resource
 	}
 
 	log.Printf("[INFO] Creating new config map: %#v", cfgMap)
-	out, err := conn.CoreV1().ConfigMaps(metadata.Namespace).Create(ctx, &cfgMap, metav1.CreateOptions{})
+	out := &corev1.ConfigMap{}
+	if useServerSideApply(meta) {
+		err = serverSideApplyCreate(ctx, meta, "configmaps", &cfgMap, out)
+	} else {
+		out, err = conn.CoreV1().ConfigMaps(metadata.Namespace).Create(ctx, &cfgMap, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -146,6 +151,20 @@ func resourceKubernetesConfigMapV1Update(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
+	if useServerSideApply(meta) {
+		cfgMap := corev1.ConfigMap{
+			ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+			BinaryData: expandBase64MapToByteMap(d.Get("binary_data").(map[string]interface{})),
+			Data:       expandStringMap(d.Get("data").(map[string]interface{})),
+			Immutable:  ptr.To(d.Get("immutable").(bool)),
+		}
+		log.Printf("[INFO] Updating config map %q", name)
+		if err := serverSideApplyUpdate(ctx, meta, "configmaps", &cfgMap, &corev1.ConfigMap{}); err != nil {
+			return diag.Errorf("Failed to update Config Map: %s", err)
+		}
+		return resourceKubernetesConfigMapV1Read(ctx, d, meta)
+	}
+
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
 	if d.HasChange("binary_data") {
 		oldV, newV := d.GetChange("binary_data")
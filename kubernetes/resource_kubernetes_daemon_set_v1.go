@@ -157,7 +157,12 @@ func resourceKubernetesDaemonSetV1Create(ctx context.Context, d *schema.Resource
 
 	log.Printf("[INFO] Creating new daemonset: %#v", daemonset)
 
-	out, err := conn.AppsV1().DaemonSets(metadata.Namespace).Create(ctx, &daemonset, metav1.CreateOptions{})
+	out := &appsv1.DaemonSet{}
+	if useServerSideApply(meta) {
+		err = serverSideApplyCreate(ctx, meta, "daemonsets", &daemonset, out)
+	} else {
+		out, err = conn.AppsV1().DaemonSets(metadata.Namespace).Create(ctx, &daemonset, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create daemonset: %s", err)
 	}
@@ -188,28 +193,45 @@ func resourceKubernetesDaemonSetV1Update(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-
-	if d.HasChange("spec") {
+	out := &appsv1.DaemonSet{}
+	if useServerSideApply(meta) {
 		spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		daemonset := appsv1.DaemonSet{
+			ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+			Spec:       spec,
+		}
+		log.Printf("[INFO] Updating daemonset: %q", name)
+		err = serverSideApplyUpdate(ctx, meta, "daemonsets", &daemonset, out)
+		if err != nil {
+			return diag.Errorf("Failed to update daemonset: %s", err)
+		}
+	} else {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+
+		if d.HasChange("spec") {
+			spec, err := expandDaemonSetSpec(d.Get("spec").([]interface{}))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			ops = append(ops, &ReplaceOperation{
+				Path:  "/spec",
+				Value: spec,
+			})
+		}
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations: %s", err)
+		}
+		log.Printf("[INFO] Updating daemonset: %q", name)
 
-		ops = append(ops, &ReplaceOperation{
-			Path:  "/spec",
-			Value: spec,
-		})
-	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return diag.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating daemonset: %q", name)
-
-	out, err := conn.AppsV1().DaemonSets(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
-	if err != nil {
-		return diag.Errorf("Failed to update daemonset: %s", err)
+		out, err = conn.AppsV1().DaemonSets(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update daemonset: %s", err)
+		}
 	}
 	log.Printf("[INFO] Submitted updated daemonset: %#v", out)
 
@@ -235,7 +235,12 @@ func resourceKubernetesDeploymentV1Create(ctx context.Context, d *schema.Resourc
 	}
 
 	log.Printf("[INFO] Creating new deployment: %#v", deployment)
-	out, err := conn.AppsV1().Deployments(metadata.Namespace).Create(ctx, &deployment, metav1.CreateOptions{})
+	out := &appsv1.Deployment{}
+	if useServerSideApply(meta) {
+		err = serverSideApplyCreate(ctx, meta, "deployments", &deployment, out)
+	} else {
+		out, err = conn.AppsV1().Deployments(metadata.Namespace).Create(ctx, &deployment, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.Errorf("Failed to create deployment: %s", err)
 	}
@@ -269,38 +274,55 @@ func resourceKubernetesDeploymentV1Update(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-
-	if d.HasChange("spec") {
+	out := &appsv1.Deployment{}
+	if useServerSideApply(meta) {
 		spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		deployment := appsv1.Deployment{
+			ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+			Spec:       *spec,
+		}
+		log.Printf("[INFO] Updating deployment %q", name)
+		err = serverSideApplyUpdate(ctx, meta, "deployments", &deployment, out)
+		if err != nil {
+			return diag.Errorf("Failed to update deployment: %s", err)
+		}
+	} else {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
 
-		ops = append(ops, &ReplaceOperation{
-			Path:  "/spec",
-			Value: spec,
-		})
-	}
-
-	if d.HasChange("spec.0.strategy") {
-		o, n := d.GetChange("spec.0.strategy.0.type")
+		if d.HasChange("spec") {
+			spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
+			if err != nil {
+				return diag.FromErr(err)
+			}
 
-		if o.(string) == "RollingUpdate" && n.(string) == "Recreate" {
-			ops = append(ops, &RemoveOperation{
-				Path: "/spec/strategy/rollingUpdate",
+			ops = append(ops, &ReplaceOperation{
+				Path:  "/spec",
+				Value: spec,
 			})
 		}
-	}
 
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return diag.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating deployment %q: %v", name, string(data))
-	out, err := conn.AppsV1().Deployments(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
-	if err != nil {
-		return diag.Errorf("Failed to update deployment: %s", err)
+		if d.HasChange("spec.0.strategy") {
+			o, n := d.GetChange("spec.0.strategy.0.type")
+
+			if o.(string) == "RollingUpdate" && n.(string) == "Recreate" {
+				ops = append(ops, &RemoveOperation{
+					Path: "/spec/strategy/rollingUpdate",
+				})
+			}
+		}
+
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations: %s", err)
+		}
+		log.Printf("[INFO] Updating deployment %q: %v", name, string(data))
+		out, err = conn.AppsV1().Deployments(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update deployment: %s", err)
+		}
 	}
 	log.Printf("[INFO] Submitted updated deployment: %#v", out)
 
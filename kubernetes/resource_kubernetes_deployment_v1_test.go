@@ -15,6 +15,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func TestAccKubernetesDeploymentV1_minimal(t *testing.T) {
@@ -47,6 +48,60 @@ func TestAccKubernetesDeploymentV1_minimal(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDeploymentV1_serverSideApply(t *testing.T) {
+	var conf appsv1.Deployment
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_deployment_v1.test"
+	imageName := busyboxImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDeploymentV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentV1Config_serverSideApply(name, imageName, 2, `TestAnnotationOne = "one"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.replicas", "2"),
+				),
+			},
+			{
+				// another field manager sets an annotation which Terraform leaves in place on update
+				PreConfig: func() {
+					conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+					if err != nil {
+						t.Fatal(err)
+					}
+					patch := []byte(`{"metadata":{"annotations":{"TestAnnotationOther":"other"}}}`)
+					_, err = conn.AppsV1().Deployments("default").Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: "tf-acc-test"})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccKubernetesDeploymentV1Config_serverSideApply(name, imageName, 3, `TestAnnotationOne = "one"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.replicas", "3"),
+					func(s *terraform.State) error {
+						if v := conf.ObjectMeta.Annotations["TestAnnotationOther"]; v != "other" {
+							return fmt.Errorf("expected the annotation of the other field manager to be kept, got %q", v)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// setting the field owned by the other field manager conflicts unless force_conflicts is set
+				Config:      testAccKubernetesDeploymentV1Config_serverSideApply(name, imageName, 3, `TestAnnotationOne = "one"`+"\n"+`TestAnnotationOther = "terraform"`),
+				ExpectError: regexp.MustCompile("Another client is managing a field Terraform tried to update"),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesDeploymentV1_basic(t *testing.T) {
 	var conf appsv1.Deployment
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -1338,6 +1393,48 @@ func testAccKubernetesDeploymentV1Config_minimal(name, imageName string) string
 `, name, imageName)
 }
 
+func testAccKubernetesDeploymentV1Config_serverSideApply(name, imageName string, replicas int, annotations string) string {
+	return fmt.Sprintf(`provider "kubernetes" {
+  apply_mode = "server_side"
+  ignore_annotations = [
+    "TestAnnotationOther",
+  ]
+}
+
+resource "kubernetes_deployment_v1" "test" {
+  metadata {
+    name = "%s"
+    annotations = {
+      %s
+    }
+  }
+  spec {
+    replicas = %d
+    selector {
+      match_labels = {
+        TestLabelOne = "one"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image   = "%s"
+          name    = "tf-acc-test"
+          command = ["sleep", "300"]
+        }
+        termination_grace_period_seconds = 1
+      }
+    }
+  }
+}
+`, name, annotations, replicas, imageName)
+}
+
 func testAccKubernetesDeploymentV1Config_basic(name, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_deployment_v1" "test" {
   metadata {
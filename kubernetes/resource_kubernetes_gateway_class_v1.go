@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func resourceKubernetesGatewayClassV1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "GatewayClass describes a class of Gateways available to the user for creating Gateway resources. It is implemented by the controller named in its spec.",
+		CreateContext: resourceKubernetesGatewayClassV1Create,
+		ReadContext:   resourceKubernetesGatewayClassV1Read,
+		UpdateContext: resourceKubernetesGatewayClassV1Update,
+		DeleteContext: resourceKubernetesGatewayClassV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("gateway class", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the desired state of the GatewayClass.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"controller_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the controller that is managing Gateways of this class, as a domain prefixed path such as `example.net/gateway-controller`. Cannot be updated.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description helps describe a GatewayClass with more details.",
+							Optional:    true,
+						},
+						"parameters_ref": {
+							Type:        schema.TypeList,
+							Description: "A reference to a resource that contains the configuration parameters corresponding to the GatewayClass.",
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group": {
+										Type:        schema.TypeString,
+										Description: "Group of the referent. Leave empty for the core API group.",
+										Optional:    true,
+									},
+									"kind": {
+										Type:        schema.TypeString,
+										Description: "Kind of the referent.",
+										Required:    true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Name of the referent.",
+										Required:    true,
+									},
+									"namespace": {
+										Type:        schema.TypeString,
+										Description: "Namespace of the referent. Required when the referent is namespaced.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": gatewayAPIConditionSchema(),
+					},
+				},
+			},
+			"wait_for_accepted": gatewayAPIWaitForAcceptedSchema("Terraform will wait for the controller to report the `Accepted` condition of the GatewayClass before considering the resource created."),
+		},
+	}
+}
+
+func gatewayClassV1Accepted(obj *unstructured.Unstructured) error {
+	conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return err
+	}
+	return gatewayAPIConditionsTrue(conditions, obj.GetGeneration(), "Accepted")
+}
+
+func resourceKubernetesGatewayClassV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec := expandGatewayClassV1Spec(d.Get("spec").([]interface{}))
+	err := createCustomResourceObject(ctx, d, meta, gatewayClassV1Kind, map[string]interface{}{"spec": spec})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_accepted").(bool) {
+		err = waitForCustomResource(ctx, d, meta, gatewayClassV1Kind, schema.TimeoutCreate, "accepted", gatewayClassV1Accepted)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesGatewayClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGatewayClassV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	obj, err := readCustomResourceObject(ctx, d, meta, gatewayClassV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if obj == nil {
+		d.SetId("")
+		return nil
+	}
+
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	err = d.Set("spec", flattenGatewayClassV1Spec(spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	err = d.Set("status", []interface{}{map[string]interface{}{
+		"condition": flattenGatewayAPIConditions(conditions),
+	}})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesGatewayClassV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec := expandGatewayClassV1Spec(d.Get("spec").([]interface{}))
+	err := updateCustomResourceObject(ctx, d, meta, gatewayClassV1Kind, patchCustomResourceSpec(d, spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesGatewayClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGatewayClassV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := deleteCustomResourceObject(ctx, d, meta, gatewayClassV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesGatewayClassV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_gateway_class_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfCRDNotInstalled(t, gatewayClassV1Kind)
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCustomResourceObjectDestroy(gatewayClassV1Kind, "kubernetes_gateway_class_v1"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesGatewayClassV1Config(name, "Managed by Terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(gatewayClassV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.controller_name", "example.net/gateway-controller"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.description", "Managed by Terraform"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_accepted"},
+			},
+			{
+				Config: testAccKubernetesGatewayClassV1Config(name, "Updated by Terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(gatewayClassV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.description", "Updated by Terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesGatewayClassV1Config(name, description string) string {
+	return fmt.Sprintf(`resource "kubernetes_gateway_class_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    controller_name = "example.net/gateway-controller"
+    description     = %q
+  }
+}
+`, name, description)
+}
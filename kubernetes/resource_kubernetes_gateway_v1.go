@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func resourceKubernetesGatewayV1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Gateway represents an instance of a service-traffic handling infrastructure by binding listeners to a set of IP addresses. Routes attach to the listeners of a Gateway to receive traffic.",
+		CreateContext: resourceKubernetesGatewayV1Create,
+		ReadContext:   resourceKubernetesGatewayV1Read,
+		UpdateContext: resourceKubernetesGatewayV1Update,
+		DeleteContext: resourceKubernetesGatewayV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: resourceKubernetesGatewayV1Schema(),
+	}
+}
+
+func resourceKubernetesGatewayV1Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": namespacedMetadataSchema("gateway", true),
+		"spec": {
+			Type:        schema.TypeList,
+			Description: "Spec defines the desired state of the Gateway.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"address": {
+						Type:        schema.TypeList,
+						Description: "Addresses requested for this Gateway. When empty, the controller assigns an address.",
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"type": {
+									Type:        schema.TypeString,
+									Description: "Type of the address, such as `IPAddress` or `Hostname`. Defaults to `IPAddress`.",
+									Optional:    true,
+									Computed:    true,
+								},
+								"value": {
+									Type:        schema.TypeString,
+									Description: "Value of the address.",
+									Required:    true,
+								},
+							},
+						},
+					},
+					"gateway_class_name": {
+						Type:        schema.TypeString,
+						Description: "The name of the GatewayClass used for this Gateway.",
+						Required:    true,
+					},
+					"listener": {
+						Type:        schema.TypeList,
+						Description: "Logical endpoints that are bound on this Gateway's addresses.",
+						Required:    true,
+						MinItems:    1,
+						MaxItems:    64,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"allowed_routes": {
+									Type:        schema.TypeList,
+									Description: "The types of routes that may be attached to the listener and the namespaces they may come from. Defaults to routes of the Gateway's namespace.",
+									Optional:    true,
+									Computed:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"kind": {
+												Type:        schema.TypeList,
+												Description: "The kinds of routes allowed to attach to the listener. Defaults to the kinds supported by the listener's protocol.",
+												Optional:    true,
+												Computed:    true,
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"group": {
+															Type:        schema.TypeString,
+															Description: "Group of the route. Defaults to `gateway.networking.k8s.io`.",
+															Optional:    true,
+															Computed:    true,
+														},
+														"kind": {
+															Type:        schema.TypeString,
+															Description: "Kind of the route, such as `HTTPRoute`.",
+															Required:    true,
+														},
+													},
+												},
+											},
+											"namespaces": {
+												Type:        schema.TypeList,
+												Description: "The namespaces from which routes may be attached to the listener.",
+												Optional:    true,
+												Computed:    true,
+												MaxItems:    1,
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"from": {
+															Type:         schema.TypeString,
+															Description:  "Where routes may be attached from: `All`, `Same` or `Selector`. Defaults to `Same`.",
+															Optional:     true,
+															Default:      "Same",
+															ValidateFunc: validation.StringInSlice([]string{"All", "Same", "Selector"}, false),
+														},
+														"selector": {
+															Type:        schema.TypeList,
+															Description: "Selects the namespaces routes may be attached from, when `from` is `Selector`.",
+															Optional:    true,
+															MaxItems:    1,
+															Elem: &schema.Resource{
+																Schema: labelSelectorFields(true),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								"hostname": {
+									Type:        schema.TypeString,
+									Description: "The virtual hostname to match for protocol types that define this concept, such as `HTTPS`. Leave empty to match all hostnames.",
+									Optional:    true,
+								},
+								"name": {
+									Type:        schema.TypeString,
+									Description: "The name of the listener, unique within the Gateway.",
+									Required:    true,
+								},
+								"port": {
+									Type:         schema.TypeInt,
+									Description:  "The network port the listener binds to.",
+									Required:     true,
+									ValidateFunc: validation.IsPortNumber,
+								},
+								"protocol": {
+									Type:        schema.TypeString,
+									Description: "The network protocol the listener expects to receive, such as `HTTP`, `HTTPS`, `TLS`, `TCP` or `UDP`.",
+									Required:    true,
+								},
+								"tls": {
+									Type:        schema.TypeList,
+									Description: "The TLS configuration of the listener. Required when the protocol is `HTTPS`.",
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"certificate_ref": {
+												Type:        schema.TypeList,
+												Description: "References to the objects, usually Secrets, holding the TLS certificates and private keys.",
+												Optional:    true,
+												MaxItems:    64,
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"group": {
+															Type:        schema.TypeString,
+															Description: "Group of the referent. Defaults to the core API group.",
+															Optional:    true,
+														},
+														"kind": {
+															Type:        schema.TypeString,
+															Description: "Kind of the referent. Defaults to `Secret`.",
+															Optional:    true,
+															Computed:    true,
+														},
+														"name": {
+															Type:        schema.TypeString,
+															Description: "Name of the referent.",
+															Required:    true,
+														},
+														"namespace": {
+															Type:        schema.TypeString,
+															Description: "Namespace of the referent. Defaults to the namespace of the Gateway. A reference to another namespace must be allowed by a ReferenceGrant in that namespace.",
+															Optional:    true,
+														},
+													},
+												},
+											},
+											"mode": {
+												Type:         schema.TypeString,
+												Description:  "The TLS behavior of the listener: `Terminate` or `Passthrough`. Defaults to `Terminate`.",
+												Optional:     true,
+												Computed:     true,
+												ValidateFunc: validation.StringInSlice([]string{"Terminate", "Passthrough"}, false),
+											},
+											"options": {
+												Type:        schema.TypeMap,
+												Description: "Implementation-specific TLS options.",
+												Optional:    true,
+												Elem:        &schema.Schema{Type: schema.TypeString},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"status": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"address": {
+						Type:        schema.TypeList,
+						Description: "The addresses which have been bound to the Gateway.",
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"type": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"value": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+					"condition": gatewayAPIConditionSchema(),
+					"listener": {
+						Type:        schema.TypeList,
+						Description: "The status of each listener of the Gateway.",
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"attached_routes": {
+									Type:        schema.TypeInt,
+									Description: "The number of routes which have been successfully attached to the listener.",
+									Computed:    true,
+								},
+								"condition": gatewayAPIConditionSchema(),
+								"name": {
+									Type:        schema.TypeString,
+									Description: "The name of the listener.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"wait_for_accepted": gatewayAPIWaitForAcceptedSchema("Terraform will wait for the controller to report the `Accepted` condition of the Gateway, and the `ResolvedRefs` condition of each of its listeners, before considering the resource created or updated."),
+	}
+}
+
+func gatewayV1Accepted(obj *unstructured.Unstructured) error {
+	conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return err
+	}
+	err = gatewayAPIConditionsTrue(conditions, obj.GetGeneration(), "Accepted")
+	if err != nil {
+		return err
+	}
+	listeners, _, err := unstructured.NestedSlice(obj.Object, "status", "listeners")
+	if err != nil {
+		return err
+	}
+	for _, l := range unstructuredMaps(listeners) {
+		conditions, _ := l["conditions"].([]interface{})
+		err := gatewayAPIConditionsTrue(conditions, obj.GetGeneration(), "ResolvedRefs")
+		if err != nil {
+			return fmt.Errorf("listener %v: %s", l["name"], err)
+		}
+	}
+	return nil
+}
+
+func resourceKubernetesGatewayV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec := expandGatewayV1Spec(d.Get("spec").([]interface{}))
+	err := createCustomResourceObject(ctx, d, meta, gatewayV1Kind, map[string]interface{}{"spec": spec})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_accepted").(bool) {
+		err = waitForCustomResource(ctx, d, meta, gatewayV1Kind, schema.TimeoutCreate, "accepted", gatewayV1Accepted)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesGatewayV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGatewayV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	obj, err := readCustomResourceObject(ctx, d, meta, gatewayV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if obj == nil {
+		d.SetId("")
+		return nil
+	}
+
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	err = d.Set("spec", flattenGatewayV1Spec(spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	status, _, _ := unstructured.NestedMap(obj.Object, "status")
+	err = d.Set("status", flattenGatewayV1Status(status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesGatewayV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec := expandGatewayV1Spec(d.Get("spec").([]interface{}))
+	err := updateCustomResourceObject(ctx, d, meta, gatewayV1Kind, patchCustomResourceSpec(d, spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_accepted").(bool) && d.HasChange("spec") {
+		err = waitForCustomResource(ctx, d, meta, gatewayV1Kind, schema.TimeoutUpdate, "accepted", gatewayV1Accepted)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesGatewayV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGatewayV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := deleteCustomResourceObject(ctx, d, meta, gatewayV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
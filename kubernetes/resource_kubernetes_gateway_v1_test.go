@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesGatewayV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_gateway_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfCRDNotInstalled(t, gatewayV1Kind)
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCustomResourceObjectDestroy(gatewayV1Kind, "kubernetes_gateway_v1"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesGatewayV1Config_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(gatewayV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.namespace", "default"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.gateway_class_name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.name", "http"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.protocol", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.allowed_routes.0.namespaces.0.from", "Same"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_accepted"},
+			},
+			{
+				Config: testAccKubernetesGatewayV1Config_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(gatewayV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.allowed_routes.0.namespaces.0.from", "Selector"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.allowed_routes.0.namespaces.0.selector.0.match_labels.gateway-access", "true"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.1.name", "https"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.1.hostname", "*.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.1.tls.0.mode", "Terminate"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.1.tls.0.certificate_ref.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.1.tls.0.certificate_ref.0.kind", "Secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesGatewayV1Config_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_gateway_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  spec {
+    gateway_class_name = %[1]q
+
+    listener {
+      name     = "http"
+      port     = 80
+      protocol = "HTTP"
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesGatewayV1Config_modified(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_gateway_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  spec {
+    gateway_class_name = %[1]q
+
+    listener {
+      name     = "http"
+      port     = 80
+      protocol = "HTTP"
+
+      allowed_routes {
+        namespaces {
+          from = "Selector"
+
+          selector {
+            match_labels = {
+              gateway-access = "true"
+            }
+          }
+        }
+      }
+    }
+
+    listener {
+      name     = "https"
+      hostname = "*.example.com"
+      port     = 443
+      protocol = "HTTPS"
+
+      tls {
+        certificate_ref {
+          name = %[1]q
+        }
+      }
+    }
+  }
+}
+`, name)
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func resourceKubernetesGRPCRouteV1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "GRPCRoute provides a way to route gRPC requests from the listeners of a Gateway to backends, by matching requests on their host, service, method or headers.",
+		CreateContext: resourceKubernetesGRPCRouteV1Create,
+		ReadContext:   resourceKubernetesGRPCRouteV1Read,
+		UpdateContext: resourceKubernetesGRPCRouteV1Update,
+		DeleteContext: resourceKubernetesGRPCRouteV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: resourceKubernetesGRPCRouteV1Schema(),
+	}
+}
+
+func resourceKubernetesGRPCRouteV1Schema() map[string]*schema.Schema {
+	spec := gatewayAPIRouteSpecFields()
+	spec["rule"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Rules are a list of gRPC matchers, filters and actions.",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"backend_ref": {
+					Type:        schema.TypeList,
+					Description: "The backends where matching requests are sent.",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: gatewayAPIBackendReferenceFields(true),
+					},
+				},
+				"filter": {
+					Type:        schema.TypeList,
+					Description: "Filters which modify requests or responses matching the rule.",
+					Optional:    true,
+					MaxItems:    16,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"request_header_modifier":  gatewayAPIHeaderModifierSchema("Modifies the headers of the request."),
+							"request_mirror":           gatewayAPIRequestMirrorSchema(),
+							"response_header_modifier": gatewayAPIHeaderModifierSchema("Modifies the headers of the response."),
+							"type": {
+								Type:        schema.TypeString,
+								Description: "The type of the filter: `RequestHeaderModifier`, `ResponseHeaderModifier` or `RequestMirror`. The block of the same name configures it.",
+								Required:    true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"RequestHeaderModifier",
+									"ResponseHeaderModifier",
+									"RequestMirror",
+								}, false),
+							},
+						},
+					},
+				},
+				"match": {
+					Type:        schema.TypeList,
+					Description: "Conditions used for matching the rule against incoming gRPC requests. A request matches the rule if it matches any of them. When empty, all requests match.",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"header": {
+								Type:        schema.TypeList,
+								Description: "Metadata headers the request must have.",
+								Optional:    true,
+								MaxItems:    16,
+								Elem: &schema.Resource{
+									Schema: gatewayAPIHeaderMatchFields([]string{"Exact", "RegularExpression"}),
+								},
+							},
+							"method": {
+								Type:        schema.TypeList,
+								Description: "The gRPC service and method the request must be for.",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"method": {
+											Type:        schema.TypeString,
+											Description: "The name of the method to match. Matches all methods of the service when empty.",
+											Optional:    true,
+										},
+										"service": {
+											Type:        schema.TypeString,
+											Description: "The fully qualified name of the service to match, such as `foo.bar.Greeter`. Matches all services when empty.",
+											Optional:    true,
+										},
+										"type": {
+											Type:         schema.TypeString,
+											Description:  "How to match against the service and method: `Exact` or `RegularExpression`. Defaults to `Exact`.",
+											Optional:     true,
+											Default:      "Exact",
+											ValidateFunc: validation.StringInSlice([]string{"Exact", "RegularExpression"}, false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return map[string]*schema.Schema{
+		"metadata": namespacedMetadataSchema("gRPC route", true),
+		"spec": {
+			Type:        schema.TypeList,
+			Description: "Spec defines the desired state of the GRPCRoute.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: spec,
+			},
+		},
+		"status":            gatewayAPIRouteStatusSchema(),
+		"wait_for_accepted": gatewayAPIWaitForAcceptedSchema("Terraform will wait for every parent of the route to report the `Accepted` and `ResolvedRefs` conditions before considering the resource created or updated."),
+	}
+}
+
+func resourceKubernetesGRPCRouteV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec := expandGRPCRouteV1Spec(d.Get("spec").([]interface{}))
+	err := createCustomResourceObject(ctx, d, meta, grpcRouteV1Kind, map[string]interface{}{"spec": spec})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_accepted").(bool) {
+		err = waitForCustomResource(ctx, d, meta, grpcRouteV1Kind, schema.TimeoutCreate, "accepted", routeParentsAccepted)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesGRPCRouteV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGRPCRouteV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	obj, err := readCustomResourceObject(ctx, d, meta, grpcRouteV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if obj == nil {
+		d.SetId("")
+		return nil
+	}
+
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	err = d.Set("spec", flattenGRPCRouteV1Spec(spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	status, _, _ := unstructured.NestedMap(obj.Object, "status")
+	err = d.Set("status", flattenGatewayAPIRouteStatus(status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesGRPCRouteV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec := expandGRPCRouteV1Spec(d.Get("spec").([]interface{}))
+	err := updateCustomResourceObject(ctx, d, meta, grpcRouteV1Kind, patchCustomResourceSpec(d, spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_accepted").(bool) && d.HasChange("spec") {
+		err = waitForCustomResource(ctx, d, meta, grpcRouteV1Kind, schema.TimeoutUpdate, "accepted", routeParentsAccepted)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesGRPCRouteV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGRPCRouteV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := deleteCustomResourceObject(ctx, d, meta, grpcRouteV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesGRPCRouteV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_grpc_route_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfCRDNotInstalled(t, grpcRouteV1Kind)
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCustomResourceObjectDestroy(grpcRouteV1Kind, "kubernetes_grpc_route_v1"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesGRPCRouteV1Config(name, "SayHello"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(grpcRouteV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parent_ref.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.match.0.method.0.type", "Exact"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.match.0.method.0.service", "helloworld.Greeter"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.match.0.method.0.method", "SayHello"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.backend_ref.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.backend_ref.0.port", "50051"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_accepted"},
+			},
+			{
+				Config: testAccKubernetesGRPCRouteV1Config(name, "SayGoodbye"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(grpcRouteV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.match.0.method.0.method", "SayGoodbye"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesGRPCRouteV1Config(name, method string) string {
+	return fmt.Sprintf(`resource "kubernetes_grpc_route_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  spec {
+    parent_ref {
+      name = %[1]q
+    }
+
+    rule {
+      match {
+        method {
+          service = "helloworld.Greeter"
+          method  = %[2]q
+        }
+      }
+
+      backend_ref {
+        name = %[1]q
+        port = 50051
+      }
+    }
+  }
+}
+`, name, method)
+}
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func resourceKubernetesHTTPRouteV1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "HTTPRoute provides a way to route HTTP requests from the listeners of a Gateway to backends, by matching requests on their host, path, headers, query parameters or method.",
+		CreateContext: resourceKubernetesHTTPRouteV1Create,
+		ReadContext:   resourceKubernetesHTTPRouteV1Read,
+		UpdateContext: resourceKubernetesHTTPRouteV1Update,
+		DeleteContext: resourceKubernetesHTTPRouteV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: resourceKubernetesHTTPRouteV1Schema(),
+	}
+}
+
+func httpRouteV1PathModifierSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Defines how the path of the request is modified.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"replace_full_path": {
+					Type:        schema.TypeString,
+					Description: "The value with which to replace the full path of the request, when `type` is `ReplaceFullPath`.",
+					Optional:    true,
+				},
+				"replace_prefix_match": {
+					Type:        schema.TypeString,
+					Description: "The value with which to replace the prefix matched by a `PathPrefix` match, when `type` is `ReplacePrefixMatch`.",
+					Optional:    true,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Description:  "The type of path modifier: `ReplaceFullPath` or `ReplacePrefixMatch`.",
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"ReplaceFullPath", "ReplacePrefixMatch"}, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesHTTPRouteV1Schema() map[string]*schema.Schema {
+	spec := gatewayAPIRouteSpecFields()
+	spec["rule"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Rules are a list of HTTP matchers, filters and actions.",
+		Optional:    true,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"backend_ref": {
+					Type:        schema.TypeList,
+					Description: "The backends where matching requests are sent.",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: gatewayAPIBackendReferenceFields(true),
+					},
+				},
+				"filter": {
+					Type:        schema.TypeList,
+					Description: "Filters which modify requests or responses matching the rule.",
+					Optional:    true,
+					MaxItems:    16,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"request_header_modifier":  gatewayAPIHeaderModifierSchema("Modifies the headers of the request."),
+							"request_mirror":           gatewayAPIRequestMirrorSchema(),
+							"response_header_modifier": gatewayAPIHeaderModifierSchema("Modifies the headers of the response."),
+							"request_redirect": {
+								Type:        schema.TypeList,
+								Description: "Responds to the request with a redirect.",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"hostname": {
+											Type:        schema.TypeString,
+											Description: "The hostname to use in the Location header. Defaults to the hostname of the request.",
+											Optional:    true,
+										},
+										"path": httpRouteV1PathModifierSchema(),
+										"port": {
+											Type:         schema.TypeInt,
+											Description:  "The port to use in the Location header.",
+											Optional:     true,
+											ValidateFunc: validation.IsPortNumber,
+										},
+										"scheme": {
+											Type:         schema.TypeString,
+											Description:  "The scheme to use in the Location header: `http` or `https`. Defaults to the scheme of the request.",
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice([]string{"http", "https"}, false),
+										},
+										"status_code": {
+											Type:         schema.TypeInt,
+											Description:  "The HTTP status code of the redirect: 301 or 302. Defaults to 302.",
+											Optional:     true,
+											Default:      302,
+											ValidateFunc: validation.IntInSlice([]int{301, 302}),
+										},
+									},
+								},
+							},
+							"type": {
+								Type:        schema.TypeString,
+								Description: "The type of the filter: `RequestHeaderModifier`, `ResponseHeaderModifier`, `RequestMirror`, `RequestRedirect` or `URLRewrite`. The block of the same name configures it.",
+								Required:    true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"RequestHeaderModifier",
+									"ResponseHeaderModifier",
+									"RequestMirror",
+									"RequestRedirect",
+									"URLRewrite",
+								}, false),
+							},
+							"url_rewrite": {
+								Type:        schema.TypeList,
+								Description: "Rewrites the request before it is forwarded to the backend.",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"hostname": {
+											Type:        schema.TypeString,
+											Description: "The value with which to replace the Host header of the request.",
+											Optional:    true,
+										},
+										"path": httpRouteV1PathModifierSchema(),
+									},
+								},
+							},
+						},
+					},
+				},
+				"match": {
+					Type:        schema.TypeList,
+					Description: "Conditions used for matching the rule against incoming HTTP requests. A request matches the rule if it matches any of them. Defaults to a match of the `/` path prefix.",
+					Optional:    true,
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"header": {
+								Type:        schema.TypeList,
+								Description: "Headers the request must have.",
+								Optional:    true,
+								MaxItems:    16,
+								Elem: &schema.Resource{
+									Schema: gatewayAPIHeaderMatchFields([]string{"Exact", "RegularExpression"}),
+								},
+							},
+							"method": {
+								Type:        schema.TypeString,
+								Description: "The HTTP method of the request.",
+								Optional:    true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"GET", "HEAD", "POST", "PUT", "DELETE", "CONNECT", "OPTIONS", "TRACE", "PATCH",
+								}, false),
+							},
+							"path": {
+								Type:        schema.TypeList,
+								Description: "The path the request must match. Defaults to the `/` path prefix.",
+								Optional:    true,
+								Computed:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"type": {
+											Type:         schema.TypeString,
+											Description:  "How to match against the path: `Exact`, `PathPrefix` or `RegularExpression`. Defaults to `PathPrefix`.",
+											Optional:     true,
+											Default:      "PathPrefix",
+											ValidateFunc: validation.StringInSlice([]string{"Exact", "PathPrefix", "RegularExpression"}, false),
+										},
+										"value": {
+											Type:        schema.TypeString,
+											Description: "The path to match. Defaults to `/`.",
+											Optional:    true,
+											Default:     "/",
+										},
+									},
+								},
+							},
+							"query_param": {
+								Type:        schema.TypeList,
+								Description: "Query parameters the request must have.",
+								Optional:    true,
+								MaxItems:    16,
+								Elem: &schema.Resource{
+									Schema: gatewayAPIHeaderMatchFields([]string{"Exact", "RegularExpression"}),
+								},
+							},
+						},
+					},
+				},
+				"timeouts": {
+					Type:        schema.TypeList,
+					Description: "Timeouts for requests matching the rule.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"backend_request": {
+								Type:        schema.TypeString,
+								Description: "Timeout for a single request from the gateway to a backend, as a duration such as `10s`.",
+								Optional:    true,
+							},
+							"request": {
+								Type:        schema.TypeString,
+								Description: "Timeout for the gateway to respond to the request, as a duration such as `30s`.",
+								Optional:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return map[string]*schema.Schema{
+		"metadata": namespacedMetadataSchema("HTTP route", true),
+		"spec": {
+			Type:        schema.TypeList,
+			Description: "Spec defines the desired state of the HTTPRoute.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: spec,
+			},
+		},
+		"status":            gatewayAPIRouteStatusSchema(),
+		"wait_for_accepted": gatewayAPIWaitForAcceptedSchema("Terraform will wait for every parent of the route to report the `Accepted` and `ResolvedRefs` conditions before considering the resource created or updated."),
+	}
+}
+
+func resourceKubernetesHTTPRouteV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec := expandHTTPRouteV1Spec(d.Get("spec").([]interface{}))
+	err := createCustomResourceObject(ctx, d, meta, httpRouteV1Kind, map[string]interface{}{"spec": spec})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_accepted").(bool) {
+		err = waitForCustomResource(ctx, d, meta, httpRouteV1Kind, schema.TimeoutCreate, "accepted", routeParentsAccepted)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesHTTPRouteV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesHTTPRouteV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	obj, err := readCustomResourceObject(ctx, d, meta, httpRouteV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if obj == nil {
+		d.SetId("")
+		return nil
+	}
+
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	err = d.Set("spec", flattenHTTPRouteV1Spec(spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	status, _, _ := unstructured.NestedMap(obj.Object, "status")
+	err = d.Set("status", flattenGatewayAPIRouteStatus(status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesHTTPRouteV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec := expandHTTPRouteV1Spec(d.Get("spec").([]interface{}))
+	err := updateCustomResourceObject(ctx, d, meta, httpRouteV1Kind, patchCustomResourceSpec(d, spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_accepted").(bool) && d.HasChange("spec") {
+		err = waitForCustomResource(ctx, d, meta, httpRouteV1Kind, schema.TimeoutUpdate, "accepted", routeParentsAccepted)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesHTTPRouteV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesHTTPRouteV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := deleteCustomResourceObject(ctx, d, meta, httpRouteV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesHTTPRouteV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_http_route_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfCRDNotInstalled(t, httpRouteV1Kind)
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCustomResourceObjectDestroy(httpRouteV1Kind, "kubernetes_http_route_v1"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesHTTPRouteV1Config_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(httpRouteV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parent_ref.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parent_ref.0.group", "gateway.networking.k8s.io"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parent_ref.0.kind", "Gateway"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.hostnames.0", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.match.0.path.0.type", "PathPrefix"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.match.0.path.0.value", "/api"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.backend_ref.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.backend_ref.0.kind", "Service"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.backend_ref.0.port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.backend_ref.0.weight", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_accepted"},
+			},
+			{
+				Config: testAccKubernetesHTTPRouteV1Config_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(httpRouteV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.match.0.header.0.name", "x-canary"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.match.0.header.0.type", "Exact"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.filter.0.type", "RequestHeaderModifier"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.filter.0.request_header_modifier.0.set.0.name", "x-env"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.backend_ref.0.weight", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.1.filter.0.type", "RequestRedirect"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.1.filter.0.request_redirect.0.scheme", "https"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.1.filter.0.request_redirect.0.status_code", "301"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.1.match.0.path.0.value", "/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesHTTPRouteV1Config_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_http_route_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  spec {
+    hostnames = ["example.com"]
+
+    parent_ref {
+      name = %[1]q
+    }
+
+    rule {
+      match {
+        path {
+          value = "/api"
+        }
+      }
+
+      backend_ref {
+        name = %[1]q
+        port = 8080
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesHTTPRouteV1Config_modified(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_http_route_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  spec {
+    hostnames = ["example.com"]
+
+    parent_ref {
+      name         = %[1]q
+      section_name = "http"
+    }
+
+    rule {
+      match {
+        path {
+          value = "/api"
+        }
+
+        header {
+          name  = "x-canary"
+          value = "true"
+        }
+      }
+
+      filter {
+        type = "RequestHeaderModifier"
+
+        request_header_modifier {
+          set {
+            name  = "x-env"
+            value = "canary"
+          }
+        }
+      }
+
+      backend_ref {
+        name   = %[1]q
+        port   = 8080
+        weight = 0
+      }
+    }
+
+    rule {
+      filter {
+        type = "RequestRedirect"
+
+        request_redirect {
+          scheme      = "https"
+          status_code = 301
+        }
+      }
+    }
+  }
+}
+`, name)
+}
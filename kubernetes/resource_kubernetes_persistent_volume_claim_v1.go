@@ -17,6 +17,8 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 )
 
@@ -30,6 +32,8 @@ func resourceKubernetesPersistentVolumeClaimV1() *schema.Resource {
 		Optional:    true,
 		Default:     true,
 	}
+	fields["spec"].Elem.(*schema.Resource).Schema["volume_attributes_class_name"] = persistentVolumeClaimVolumeAttributesClassNameSchema()
+	fields["status"] = persistentVolumeClaimStatusSchema()
 	return &schema.Resource{
 		Description:   "This resource allows the user to request for and claim to a persistent volume.",
 		CreateContext: resourceKubernetesPersistentVolumeClaimV1Create,
@@ -45,17 +49,26 @@ func resourceKubernetesPersistentVolumeClaimV1() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: fields,
 
-		// All fields of Spec are immutable after creation, except for resources.requests.storage.
+		// All fields of Spec are immutable after creation, except for resources.requests.storage and volume_attributes_class_name.
 		// Storage can only be increased in place. A new object will be created when the storage is decreased.
+		// Likewise, the volume attributes class can be changed in place but not removed.
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 			// Skip custom logic for resource creation.
 			if diff.Id() == "" {
 				return nil
 			}
+			keyClass := "spec.0.volume_attributes_class_name"
+			if old, new := diff.GetChange(keyClass); old.(string) != "" && new.(string) == "" {
+				err := diff.ForceNew(keyClass)
+				if err != nil {
+					return err
+				}
+			}
 			key := "spec.0.resources.0.requests"
 			subKeyStorage := "spec.0.resources.0.requests.storage"
 			subKeyLimits := "spec.0.resources.0.limits"
@@ -104,7 +117,7 @@ func resourceKubernetesPersistentVolumeClaimV1Create(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Creating new persistent volume claim: %#v", claim)
-	out, err := conn.CoreV1().PersistentVolumeClaims(claim.Namespace).Create(ctx, claim, metav1.CreateOptions{})
+	out, err := createPersistentVolumeClaimV1(ctx, meta, claim, d.Get("spec.0.volume_attributes_class_name").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -166,28 +179,42 @@ func resourceKubernetesPersistentVolumeClaimV1Read(ctx context.Context, d *schem
 		d.SetId("")
 		return diag.Diagnostics{}
 	}
-	conn, err := meta.(KubeClientsets).MainClientset()
+
+	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	namespace, name, err := idParts(d.Id())
+	client, err := persistentVolumeClaimV1Kind.client(meta, namespace)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// The claim is read as an unstructured object to keep the fields missing from k8s.io/api
 	log.Printf("[INFO] Reading persistent volume claim %s", name)
-	claim, err := conn.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	obj, err := client.Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		log.Printf("[DEBUG] Received error: %#v", err)
 		return diag.FromErr(err)
 	}
+	claim := &api.PersistentVolumeClaim{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, claim)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	log.Printf("[INFO] Received persistent volume claim: %#v", claim)
 	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = d.Set("spec", flattenPersistentVolumeClaimSpec(claim.Spec))
+	spec := flattenPersistentVolumeClaimSpec(claim.Spec)
+	className, _, _ := unstructured.NestedString(obj.Object, "spec", "volumeAttributesClassName")
+	spec[0].(map[string]interface{})["volume_attributes_class_name"] = className
+	err = d.Set("spec", spec)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	status, _, _ := unstructured.NestedMap(obj.Object, "status")
+	err = d.Set("status", flattenPersistentVolumeClaimV1Status(status))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -219,6 +246,13 @@ func resourceKubernetesPersistentVolumeClaimV1Update(ctx context.Context, d *sch
 			Value: requests,
 		})
 	}
+	// The volume attributes class can only be changed to another class, removing it forces a new claim.
+	if d.HasChange("spec.0.volume_attributes_class_name") {
+		ops = append(ops, &AddOperation{
+			Path:  "/spec/volumeAttributesClassName",
+			Value: d.Get("spec.0.volume_attributes_class_name"),
+		})
+	}
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
@@ -231,9 +265,62 @@ func resourceKubernetesPersistentVolumeClaimV1Update(ctx context.Context, d *sch
 	}
 	log.Printf("[INFO] Submitted updated persistent volume claim: %#v", out)
 
+	if d.HasChange("spec.0.volume_attributes_class_name") {
+		client, err := persistentVolumeClaimV1Kind.client(meta, namespace)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+			obj, err := client.Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+			return persistentVolumeClaimModifyVolumeStatus(obj)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		log.Printf("[INFO] Volume of persistent volume claim %s modified", name)
+	}
+
 	return resourceKubernetesPersistentVolumeClaimV1Read(ctx, d, meta)
 }
 
+// createPersistentVolumeClaimV1 creates the claim through the dynamic client when it has a
+// volume attributes class, since the field is missing from the typed client.
+func createPersistentVolumeClaimV1(ctx context.Context, meta interface{}, claim *api.PersistentVolumeClaim, volumeAttributesClassName string) (*api.PersistentVolumeClaim, error) {
+	if volumeAttributesClassName == "" {
+		conn, err := meta.(KubeClientsets).MainClientset()
+		if err != nil {
+			return nil, err
+		}
+		return conn.CoreV1().PersistentVolumeClaims(claim.Namespace).Create(ctx, claim, metav1.CreateOptions{})
+	}
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(claim)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{Object: u}
+	obj.SetGroupVersionKind(persistentVolumeClaimV1Kind.groupVersionKind())
+	err = unstructured.SetNestedField(obj.Object, volumeAttributesClassName, "spec", "volumeAttributesClassName")
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := persistentVolumeClaimV1Kind.client(meta, claim.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	out := &api.PersistentVolumeClaim{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(res.Object, out)
+	return out, err
+}
+
 func resourceKubernetesPersistentVolumeClaimV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
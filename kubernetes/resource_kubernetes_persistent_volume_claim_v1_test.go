@@ -1238,3 +1238,97 @@ func testAccCheckKubernetesPersistentVolumeClaimV1ForceNew(old, new *corev1.Pers
 		return nil
 	}
 }
+
+func TestAccKubernetesPersistentVolumeClaimV1_volumeAttributesClass(t *testing.T) {
+	var conf corev1.PersistentVolumeClaim
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_persistent_volume_claim_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.34.0")
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPersistentVolumeClaimV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPersistentVolumeClaimV1Config_volumeAttributesClass(name, "silver"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPersistentVolumeClaimV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.volume_attributes_class_name", name+"-silver"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_until_bound"},
+			},
+			{
+				// The claim is not bound, so the new class is set without waiting for a modification of its volume
+				Config: testAccKubernetesPersistentVolumeClaimV1Config_volumeAttributesClass(name, "gold"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPersistentVolumeClaimV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.volume_attributes_class_name", name+"-gold"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesPersistentVolumeClaimV1Config_volumeAttributesClass(name, class string) string {
+	return fmt.Sprintf(`resource "kubernetes_volume_attributes_class_v1" "silver" {
+  metadata {
+    name = "%[1]s-silver"
+  }
+
+  driver_name = "hostpath.csi.k8s.io"
+
+  parameters = {
+    iops = "3000"
+  }
+}
+
+resource "kubernetes_volume_attributes_class_v1" "gold" {
+  metadata {
+    name = "%[1]s-gold"
+  }
+
+  driver_name = "hostpath.csi.k8s.io"
+
+  parameters = {
+    iops = "16000"
+  }
+}
+
+resource "kubernetes_persistent_volume_claim_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  spec {
+    access_modes                 = ["ReadWriteOnce"]
+    volume_attributes_class_name = kubernetes_volume_attributes_class_v1.%[2]s.metadata.0.name
+
+    resources {
+      requests = {
+        storage = "1Gi"
+      }
+    }
+
+    selector {
+      match_expressions {
+        key      = "environment"
+        operator = "In"
+        values   = ["non-exists-12345"]
+      }
+    }
+  }
+
+  wait_until_bound = false
+}
+`, name, class)
+}
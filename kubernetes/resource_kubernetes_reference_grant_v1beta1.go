@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func resourceKubernetesReferenceGrantV1beta1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "ReferenceGrant allows Gateway API resources in other namespaces to reference resources in the namespace of the grant, such as routes referencing backend Services or Gateways referencing certificate Secrets.",
+		CreateContext: resourceKubernetesReferenceGrantV1beta1Create,
+		ReadContext:   resourceKubernetesReferenceGrantV1beta1Read,
+		UpdateContext: resourceKubernetesReferenceGrantV1beta1Update,
+		DeleteContext: resourceKubernetesReferenceGrantV1beta1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("reference grant", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the desired state of the ReferenceGrant.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:        schema.TypeList,
+							Description: "The resources which may reference the resources described in `to`.",
+							Required:    true,
+							MaxItems:    16,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group": {
+										Type:        schema.TypeString,
+										Description: "Group of the referencing resources, such as `gateway.networking.k8s.io`. Leave empty for the core API group.",
+										Optional:    true,
+									},
+									"kind": {
+										Type:        schema.TypeString,
+										Description: "Kind of the referencing resources, such as `HTTPRoute`.",
+										Required:    true,
+									},
+									"namespace": {
+										Type:        schema.TypeString,
+										Description: "Namespace of the referencing resources.",
+										Required:    true,
+									},
+								},
+							},
+						},
+						"to": {
+							Type:        schema.TypeList,
+							Description: "The resources of the namespace of the grant which may be referenced by the resources described in `from`.",
+							Required:    true,
+							MaxItems:    16,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group": {
+										Type:        schema.TypeString,
+										Description: "Group of the referenced resources. Leave empty for the core API group.",
+										Optional:    true,
+									},
+									"kind": {
+										Type:        schema.TypeString,
+										Description: "Kind of the referenced resources, such as `Service`.",
+										Required:    true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Name of the referenced resource. When empty, all resources of the kind may be referenced.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesReferenceGrantV1beta1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec := expandReferenceGrantV1beta1Spec(d.Get("spec").([]interface{}))
+	err := createCustomResourceObject(ctx, d, meta, referenceGrantV1beta1Kind, map[string]interface{}{"spec": spec})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesReferenceGrantV1beta1Read(ctx, d, meta)
+}
+
+func resourceKubernetesReferenceGrantV1beta1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	obj, err := readCustomResourceObject(ctx, d, meta, referenceGrantV1beta1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if obj == nil {
+		d.SetId("")
+		return nil
+	}
+
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	err = d.Set("spec", flattenReferenceGrantV1beta1Spec(spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesReferenceGrantV1beta1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec := expandReferenceGrantV1beta1Spec(d.Get("spec").([]interface{}))
+	err := updateCustomResourceObject(ctx, d, meta, referenceGrantV1beta1Kind, patchCustomResourceSpec(d, spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesReferenceGrantV1beta1Read(ctx, d, meta)
+}
+
+func resourceKubernetesReferenceGrantV1beta1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := deleteCustomResourceObject(ctx, d, meta, referenceGrantV1beta1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
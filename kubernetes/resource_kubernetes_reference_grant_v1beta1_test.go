@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesReferenceGrantV1beta1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_reference_grant_v1beta1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfCRDNotInstalled(t, referenceGrantV1beta1Kind)
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCustomResourceObjectDestroy(referenceGrantV1beta1Kind, "kubernetes_reference_grant_v1beta1"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesReferenceGrantV1beta1Config(name, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(referenceGrantV1beta1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.from.0.group", "gateway.networking.k8s.io"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.from.0.kind", "HTTPRoute"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.from.0.namespace", "apps"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.to.0.group", ""),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.to.0.kind", "Service"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.to.0.name", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesReferenceGrantV1beta1Config(name, "backend"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(referenceGrantV1beta1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.to.0.name", "backend"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesReferenceGrantV1beta1Config(name, serviceName string) string {
+	return fmt.Sprintf(`resource "kubernetes_reference_grant_v1beta1" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    from {
+      group     = "gateway.networking.k8s.io"
+      kind      = "HTTPRoute"
+      namespace = "apps"
+    }
+
+    to {
+      kind = "Service"
+      name = %q
+    }
+  }
+}
+`, name, serviceName)
+}
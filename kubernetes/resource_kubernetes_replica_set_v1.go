@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+func resourceKubernetesReplicaSetV1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "A ReplicaSet's purpose is to maintain a stable set of replica Pods running at any given time. It is often used to guarantee the availability of a specified number of identical Pods. Usually a Deployment, which manages ReplicaSets, is used instead of managing them directly.",
+		CreateContext: resourceKubernetesReplicaSetV1Create,
+		ReadContext:   resourceKubernetesReplicaSetV1Read,
+		UpdateContext: resourceKubernetesReplicaSetV1Update,
+		DeleteContext: resourceKubernetesReplicaSetV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: resourceKubernetesReplicaSetV1Schema(),
+	}
+}
+
+func resourceKubernetesReplicaSetV1Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": namespacedMetadataSchema("replica set", true),
+		"spec": {
+			Type:        schema.TypeList,
+			Description: "Spec defines the specification of the desired behavior of the replica set. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"min_ready_seconds": {
+						Type:        schema.TypeInt,
+						Description: "Minimum number of seconds for which a newly created pod should be ready without any of its container crashing, for it to be considered available. Defaults to 0 (pod will be considered available as soon as it is ready)",
+						Optional:    true,
+						Default:     0,
+					},
+					"replicas": {
+						Type:         schema.TypeString,
+						Description:  "Number of desired pods. This is a string to be able to distinguish between explicit zero and not specified. Defaults to 1.",
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validateTypeStringNullableInt,
+					},
+					"selector": {
+						Type:        schema.TypeList,
+						Description: "A label query over pods that should match the replica count. It must match the labels of the pod template. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors",
+						Required:    true,
+						ForceNew:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: labelSelectorFields(false),
+						},
+					},
+					"template": {
+						Type:        schema.TypeList,
+						Description: "Describes the pods that will be created if insufficient replicas are detected. Changes to the template only apply to pods created afterwards; existing pods are not replaced. More info: https://kubernetes.io/docs/concepts/workloads/controllers/replicaset/#pod-template",
+						Required:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: podTemplateFields("replica set"),
+						},
+					},
+				},
+			},
+		},
+		"wait_for_rollout": {
+			Type:        schema.TypeBool,
+			Description: "Wait for all the replicas of the replica set to be ready and available. Defaults to true.",
+			Default:     true,
+			Optional:    true,
+		},
+	}
+}
+
+func resourceKubernetesReplicaSetV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	spec, err := expandReplicaSetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rs := appsv1.ReplicaSet{
+		ObjectMeta: metadata,
+		Spec:       *spec,
+	}
+
+	log.Printf("[INFO] Creating new replica set: %#v", rs)
+	out := &appsv1.ReplicaSet{}
+	if useServerSideApply(meta) {
+		err = serverSideApplyCreate(ctx, meta, "replicasets", &rs, out)
+	} else {
+		out, err = conn.AppsV1().ReplicaSets(metadata.Namespace).Create(ctx, &rs, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return diag.Errorf("Failed to create replica set: %s", err)
+	}
+
+	d.SetId(buildId(out.ObjectMeta))
+
+	if d.Get("wait_for_rollout").(bool) {
+		log.Printf("[INFO] Waiting for replica set %s/%s to rollout", out.ObjectMeta.Namespace, out.ObjectMeta.Name)
+		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
+			waitForReplicaSetReplicasFunc(ctx, conn, out.GetNamespace(), out.GetName()))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[INFO] Submitted new replica set: %#v", out)
+
+	return resourceKubernetesReplicaSetV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesReplicaSetV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceKubernetesReplicaSetV1Exists(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading replica set %s", name)
+	rs, err := conn.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received replica set: %#v", rs)
+
+	err = d.Set("metadata", flattenMetadata(rs.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	spec, err := flattenReplicaSetSpec(rs.Spec)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("spec", spec)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesReplicaSetV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	out := &appsv1.ReplicaSet{}
+	if useServerSideApply(meta) {
+		spec, err := expandReplicaSetSpec(d.Get("spec").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rs := appsv1.ReplicaSet{
+			ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+			Spec:       *spec,
+		}
+		log.Printf("[INFO] Updating replica set %q", name)
+		err = serverSideApplyUpdate(ctx, meta, "replicasets", &rs, out)
+		if err != nil {
+			return diag.Errorf("Failed to update replica set: %s", err)
+		}
+	} else {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+
+		if d.HasChange("spec") {
+			spec, err := expandReplicaSetSpec(d.Get("spec").([]interface{}))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			ops = append(ops, &ReplaceOperation{
+				Path:  "/spec",
+				Value: spec,
+			})
+		}
+
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations: %s", err)
+		}
+		log.Printf("[INFO] Updating replica set %q: %v", name, string(data))
+		out, err = conn.AppsV1().ReplicaSets(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update replica set: %s", err)
+		}
+	}
+	log.Printf("[INFO] Submitted updated replica set: %#v", out)
+
+	if d.Get("wait_for_rollout").(bool) {
+		log.Printf("[INFO] Waiting for replica set %s/%s to rollout", out.ObjectMeta.Namespace, out.ObjectMeta.Name)
+		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
+			waitForReplicaSetReplicasFunc(ctx, conn, out.GetNamespace(), out.GetName()))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesReplicaSetV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesReplicaSetV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting replica set: %#v", name)
+
+	err = conn.AppsV1().ReplicaSets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		_, err := conn.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+				return nil
+			}
+			return retry.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("Replica set (%s) still exists", d.Id())
+		return retry.RetryableError(e)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Replica set %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesReplicaSetV1Exists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return false, err
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking replica set %s", name)
+	_, err = conn.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+func waitForReplicaSetReplicasFunc(ctx context.Context, conn *kubernetes.Clientset, ns, name string) retry.RetryFunc {
+	return func() *retry.RetryError {
+		// Query the replica set to get a status update.
+		rs, err := conn.AppsV1().ReplicaSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		return replicaSetRolloutStatus(rs)
+	}
+}
+
+// replicaSetRolloutStatus reports whether all the replicas of the replica set are ready and available.
+// A ReplicaSet does not replace its existing pods when its template changes, so this only tracks the replica count.
+func replicaSetRolloutStatus(rs *appsv1.ReplicaSet) *retry.RetryError {
+	var specReplicas int32 = 1 // default, according to API docs
+	if rs.Spec.Replicas != nil {
+		specReplicas = *rs.Spec.Replicas
+	}
+
+	if rs.Generation > rs.Status.ObservedGeneration {
+		return retry.RetryableError(fmt.Errorf("Waiting for rollout to start"))
+	}
+
+	if rs.Generation < rs.Status.ObservedGeneration {
+		return retry.NonRetryableError(fmt.Errorf("Observed generation %d is not expected to be greater than generation %d", rs.Status.ObservedGeneration, rs.Generation))
+	}
+
+	for _, c := range rs.Status.Conditions {
+		if c.Type == appsv1.ReplicaSetReplicaFailure && c.Status == corev1.ConditionTrue {
+			return retry.RetryableError(fmt.Errorf("Waiting for rollout to finish: %s: %s", c.Reason, c.Message))
+		}
+	}
+
+	if rs.Status.Replicas != specReplicas {
+		return retry.RetryableError(fmt.Errorf("Waiting for rollout to finish: %d replicas wanted; %d replicas exist", specReplicas, rs.Status.Replicas))
+	}
+
+	if rs.Status.ReadyReplicas < specReplicas {
+		return retry.RetryableError(fmt.Errorf("Waiting for rollout to finish: %d replicas wanted; %d replicas Ready", specReplicas, rs.Status.ReadyReplicas))
+	}
+
+	if rs.Status.AvailableReplicas < specReplicas {
+		return retry.RetryableError(fmt.Errorf("Waiting for rollout to finish: %d of %d replicas are available...", rs.Status.AvailableReplicas, specReplicas))
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestAccKubernetesReplicaSetV1_basic(t *testing.T) {
+	var conf1, conf2 appsv1.ReplicaSet
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_replica_set_v1.test"
+	imageName := busyboxImage
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesReplicaSetV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesReplicaSetV1Config_basic(name, imageName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesReplicaSetV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.replicas", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.selector.0.match_labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.template.0.spec.0.container.0.image", imageName),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_rollout", "true"),
+					func(s *terraform.State) error {
+						if conf1.Status.ReadyReplicas != 2 {
+							return fmt.Errorf("expected 2 ready replicas, got %d", conf1.Status.ReadyReplicas)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:   testAccKubernetesReplicaSetV1Config_basic(name, imageName, 2),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_rollout"},
+			},
+			{
+				Config: testAccKubernetesReplicaSetV1Config_basic(name, imageName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesReplicaSetV1Exists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.replicas", "3"),
+					testAccCheckKubernetesReplicaSetV1NotRecreated(&conf1, &conf2),
+					func(s *terraform.State) error {
+						if conf2.Status.ReadyReplicas != 3 {
+							return fmt.Errorf("expected 3 ready replicas, got %d", conf2.Status.ReadyReplicas)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestReplicaSetRolloutStatus(t *testing.T) {
+	rs := func(generation, observed int64, replicas int32, status appsv1.ReplicaSetStatus) *appsv1.ReplicaSet {
+		status.ObservedGeneration = observed
+		return &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{Generation: generation},
+			Spec:       appsv1.ReplicaSetSpec{Replicas: ptr.To(replicas)},
+			Status:     status,
+		}
+	}
+
+	cases := []struct {
+		name      string
+		rs        *appsv1.ReplicaSet
+		done      bool
+		retryable bool
+	}{
+		{
+			name:      "not observed yet",
+			rs:        rs(2, 1, 2, appsv1.ReplicaSetStatus{Replicas: 2, ReadyReplicas: 2, AvailableReplicas: 2}),
+			retryable: true,
+		},
+		{
+			name: "observed generation ahead",
+			rs:   rs(1, 2, 2, appsv1.ReplicaSetStatus{}),
+		},
+		{
+			name:      "scaling down",
+			rs:        rs(2, 2, 1, appsv1.ReplicaSetStatus{Replicas: 2, ReadyReplicas: 2, AvailableReplicas: 2}),
+			retryable: true,
+		},
+		{
+			name:      "not ready",
+			rs:        rs(1, 1, 2, appsv1.ReplicaSetStatus{Replicas: 2, ReadyReplicas: 1, AvailableReplicas: 1}),
+			retryable: true,
+		},
+		{
+			name:      "not available",
+			rs:        rs(1, 1, 2, appsv1.ReplicaSetStatus{Replicas: 2, ReadyReplicas: 2, AvailableReplicas: 1}),
+			retryable: true,
+		},
+		{
+			name: "replica failure",
+			rs: rs(1, 1, 2, appsv1.ReplicaSetStatus{Replicas: 2, ReadyReplicas: 2, AvailableReplicas: 2, Conditions: []appsv1.ReplicaSetCondition{
+				{Type: appsv1.ReplicaSetReplicaFailure, Status: corev1.ConditionTrue, Reason: "FailedCreate", Message: "exceeded quota"},
+			}}),
+			retryable: true,
+		},
+		{
+			name: "rolled out",
+			rs:   rs(1, 1, 2, appsv1.ReplicaSetStatus{Replicas: 2, ReadyReplicas: 2, AvailableReplicas: 2}),
+			done: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := replicaSetRolloutStatus(tc.rs)
+			if tc.done {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected the rollout to be in progress")
+			}
+			if err.Retryable != tc.retryable {
+				t.Fatalf("expected retryable to be %t, got %t: %s", tc.retryable, err.Retryable, err.Err)
+			}
+		})
+	}
+}
+
+func testAccCheckKubernetesReplicaSetV1NotRecreated(old, new *appsv1.ReplicaSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if old.UID != new.UID {
+			return fmt.Errorf("Expected replica set to be updated in place, but it was recreated (%s != %s)", old.UID, new.UID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesReplicaSetV1Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_replica_set_v1" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			if resp.Namespace == namespace && resp.Name == name {
+				return fmt.Errorf("Replica set still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesReplicaSetV1Exists(n string, obj *appsv1.ReplicaSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesReplicaSetV1Config_basic(name, imageName string, replicas int) string {
+	return fmt.Sprintf(`resource "kubernetes_replica_set_v1" "test" {
+  metadata {
+    name = "%s"
+    labels = {
+      TestLabelOne = "one"
+    }
+  }
+  spec {
+    replicas = %d
+    selector {
+      match_labels = {
+        TestLabelOne = "one"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image   = "%s"
+          name    = "tf-acc-test"
+          command = ["sleep", "300"]
+        }
+        termination_grace_period_seconds = 1
+      }
+    }
+  }
+}
+`, name, replicas, imageName)
+}
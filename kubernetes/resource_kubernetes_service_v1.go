@@ -344,7 +344,12 @@ func resourceKubernetesServiceV1Create(ctx context.Context, d *schema.ResourceDa
 		Spec:       expandServiceSpec(d.Get("spec").([]interface{})),
 	}
 	log.Printf("[INFO] Creating new service: %#v", svc)
-	out, err := conn.CoreV1().Services(metadata.Namespace).Create(ctx, &svc, metav1.CreateOptions{})
+	out := &corev1.Service{}
+	if useServerSideApply(meta) {
+		err = serverSideApplyCreate(ctx, meta, "services", &svc, out)
+	} else {
+		out, err = conn.CoreV1().Services(metadata.Namespace).Create(ctx, &svc, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -444,23 +449,36 @@ func resourceKubernetesServiceV1Update(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	if d.HasChange("spec") {
-		serverVersion, err := getServerVersion(conn)
+	out := &corev1.Service{}
+	if useServerSideApply(meta) {
+		svc := corev1.Service{
+			ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+			Spec:       expandServiceSpec(d.Get("spec").([]interface{})),
+		}
+		log.Printf("[INFO] Updating service %q", name)
+		err = serverSideApplyUpdate(ctx, meta, "services", &svc, out)
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.Errorf("Failed to update service: %s", err)
+		}
+	} else {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+		if d.HasChange("spec") {
+			serverVersion, err := getServerVersion(conn)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			diffOps := patchServiceSpec("spec.0.", "/spec/", d, serverVersion)
+			ops = append(ops, diffOps...)
+		}
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations: %s", err)
+		}
+		log.Printf("[INFO] Updating service %q: %v", name, string(data))
+		out, err = conn.CoreV1().Services(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update service: %s", err)
 		}
-		diffOps := patchServiceSpec("spec.0.", "/spec/", d, serverVersion)
-		ops = append(ops, diffOps...)
-	}
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return diag.Errorf("Failed to marshal update operations: %s", err)
-	}
-	log.Printf("[INFO] Updating service %q: %v", name, string(data))
-	out, err := conn.CoreV1().Services(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
-	if err != nil {
-		return diag.Errorf("Failed to update service: %s", err)
 	}
 	log.Printf("[INFO] Submitted updated service: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
@@ -90,8 +90,12 @@ func resourceKubernetesStatefulSetV1Create(ctx context.Context, d *schema.Resour
 	}
 	log.Printf("[INFO] Creating new StatefulSet: %#v", statefulSet)
 
-	out, err := conn.AppsV1().StatefulSets(metadata.Namespace).Create(ctx, &statefulSet, metav1.CreateOptions{})
-
+	out := &appsv1.StatefulSet{}
+	if useServerSideApply(meta) {
+		err = serverSideApplyCreate(ctx, meta, "statefulsets", &statefulSet, out)
+	} else {
+		out, err = conn.AppsV1().StatefulSets(metadata.Namespace).Create(ctx, &statefulSet, metav1.CreateOptions{})
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -195,25 +199,42 @@ func resourceKubernetesStatefulSetV1Update(ctx context.Context, d *schema.Resour
 	if err != nil {
 		return diag.Errorf("Error parsing resource ID: %#v", err)
 	}
-	ops := patchMetadata("metadata.0.", "/metadata/", d)
-
-	if d.HasChange("spec") {
-		log.Println("[TRACE] StatefulSet.Spec has changes")
-		specPatch, err := patchStatefulSetSpec(d)
+	out := &appsv1.StatefulSet{}
+	if useServerSideApply(meta) {
+		spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}))
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		ops = append(ops, specPatch...)
-	}
+		statefulSet := appsv1.StatefulSet{
+			ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+			Spec:       *spec,
+		}
+		log.Printf("[INFO] Updating StatefulSet %q", name)
+		err = serverSideApplyUpdate(ctx, meta, "statefulsets", &statefulSet, out)
+		if err != nil {
+			return diag.Errorf("Failed to update StatefulSet: %s", err)
+		}
+	} else {
+		ops := patchMetadata("metadata.0.", "/metadata/", d)
+
+		if d.HasChange("spec") {
+			log.Println("[TRACE] StatefulSet.Spec has changes")
+			specPatch, err := patchStatefulSetSpec(d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			ops = append(ops, specPatch...)
+		}
 
-	data, err := ops.MarshalJSON()
-	if err != nil {
-		return diag.Errorf("Failed to marshal update operations for StatefulSet: %s", err)
-	}
-	log.Printf("[INFO] Updating StatefulSet %q: %v", name, string(data))
-	out, err := conn.AppsV1().StatefulSets(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
-	if err != nil {
-		return diag.Errorf("Failed to update StatefulSet: %s", err)
+		data, err := ops.MarshalJSON()
+		if err != nil {
+			return diag.Errorf("Failed to marshal update operations for StatefulSet: %s", err)
+		}
+		log.Printf("[INFO] Updating StatefulSet %q: %v", name, string(data))
+		out, err = conn.AppsV1().StatefulSets(namespace).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to update StatefulSet: %s", err)
+		}
 	}
 	log.Printf("[INFO] Submitted updated StatefulSet: %#v", out)
 
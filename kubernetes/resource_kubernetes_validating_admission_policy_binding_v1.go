@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+func resourceKubernetesValidatingAdmissionPolicyBindingV1() *schema.Resource {
+	apiDoc := admissionregistrationv1beta1.ValidatingAdmissionPolicyBinding{}.SwaggerDoc()
+	specDoc := admissionregistrationv1beta1.ValidatingAdmissionPolicyBindingSpec{}.SwaggerDoc()
+	paramRefDoc := admissionregistrationv1beta1.ParamRef{}.SwaggerDoc()
+	return &schema.Resource{
+		Description:   "Validating Admission Policy Binding binds a `kubernetes_validating_admission_policy_v1` with parameters, and scopes down the resources it applies to.",
+		CreateContext: resourceKubernetesValidatingAdmissionPolicyBindingV1Create,
+		ReadContext:   resourceKubernetesValidatingAdmissionPolicyBindingV1Read,
+		UpdateContext: resourceKubernetesValidatingAdmissionPolicyBindingV1Update,
+		DeleteContext: resourceKubernetesValidatingAdmissionPolicyBindingV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("validating admission policy binding", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: apiDoc["spec"],
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"match_resources": {
+							Type:        schema.TypeList,
+							Description: specDoc["matchResources"],
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: matchResourcesFields(),
+							},
+						},
+						"param_ref": {
+							Type:        schema.TypeList,
+							Description: specDoc["paramRef"],
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: paramRefDoc["name"],
+										Optional:    true,
+									},
+									"namespace": {
+										Type:        schema.TypeString,
+										Description: paramRefDoc["namespace"],
+										Optional:    true,
+									},
+									"parameter_not_found_action": {
+										Type:        schema.TypeString,
+										Description: paramRefDoc["parameterNotFoundAction"],
+										Optional:    true,
+										Default:     string(admissionregistrationv1beta1.DenyAction),
+										ValidateFunc: validation.StringInSlice([]string{
+											string(admissionregistrationv1beta1.AllowAction),
+											string(admissionregistrationv1beta1.DenyAction),
+										}, false),
+									},
+									"selector": {
+										Type:        schema.TypeList,
+										Description: paramRefDoc["selector"],
+										Optional:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: labelSelectorFields(true),
+										},
+									},
+								},
+							},
+						},
+						"policy_name": {
+							Type:        schema.TypeString,
+							Description: specDoc["policyName"],
+							Required:    true,
+						},
+						"validation_actions": {
+							Type:        schema.TypeList,
+							Description: specDoc["validationActions"],
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(admissionregistrationv1beta1.Deny),
+									string(admissionregistrationv1beta1.Warn),
+									string(admissionregistrationv1beta1.Audit),
+								}, false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesValidatingAdmissionPolicyBindingV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newAdmissionPolicyClient(meta, validatingAdmissionPolicyBindingResource, "ValidatingAdmissionPolicyBinding")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	binding := admissionregistrationv1beta1.ValidatingAdmissionPolicyBinding{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandValidatingAdmissionPolicyBindingSpec(d.Get("spec").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new ValidatingAdmissionPolicyBinding: %#v", binding)
+
+	res := &admissionregistrationv1beta1.ValidatingAdmissionPolicyBinding{}
+	err = client.Create(ctx, &binding, res)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new ValidatingAdmissionPolicyBinding: %#v", res)
+
+	d.SetId(res.Name)
+
+	return resourceKubernetesValidatingAdmissionPolicyBindingV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesValidatingAdmissionPolicyBindingV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newAdmissionPolicyClient(meta, validatingAdmissionPolicyBindingResource, "ValidatingAdmissionPolicyBinding")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading ValidatingAdmissionPolicyBinding %s", name)
+	binding := &admissionregistrationv1beta1.ValidatingAdmissionPolicyBinding{}
+	err = client.Get(ctx, name, binding)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[INFO] ValidatingAdmissionPolicyBinding %s not found", name)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("spec", flattenValidatingAdmissionPolicyBindingSpec(binding.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesValidatingAdmissionPolicyBindingV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newAdmissionPolicyClient(meta, validatingAdmissionPolicyBindingResource, "ValidatingAdmissionPolicyBinding")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandValidatingAdmissionPolicyBindingSpec(d.Get("spec").([]interface{})),
+		})
+	}
+
+	name := d.Id()
+	res := &admissionregistrationv1beta1.ValidatingAdmissionPolicyBinding{}
+	err = client.Patch(ctx, name, ops, res)
+	if err != nil {
+		return diag.Errorf("Failed to update ValidatingAdmissionPolicyBinding: %s", err)
+	}
+
+	log.Printf("[INFO] Submitted updated ValidatingAdmissionPolicyBinding: %#v", res)
+
+	return resourceKubernetesValidatingAdmissionPolicyBindingV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesValidatingAdmissionPolicyBindingV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newAdmissionPolicyClient(meta, validatingAdmissionPolicyBindingResource, "ValidatingAdmissionPolicyBinding")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting ValidatingAdmissionPolicyBinding: %#v", name)
+	err = client.Delete(ctx, name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] ValidatingAdmissionPolicyBinding %#v is deleted", name)
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestAccKubernetesValidatingAdmissionPolicyBindingV1_basic(t *testing.T) {
+	name := fmt.Sprintf("acc-test-%v.terraform.io", acctest.RandString(10))
+	resourceName := "kubernetes_validating_admission_policy_binding_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.30.0")
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesValidatingAdmissionPolicyBindingV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesValidatingAdmissionPolicyBindingV1Config_basic(name, `["Deny"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesValidatingAdmissionPolicyBindingV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.policy_name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation_actions.0", "Deny"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.param_ref.0.name", "replica-limits"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.param_ref.0.parameter_not_found_action", "Deny"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.match_resources.0.namespace_selector.0.match_labels.environment", "test"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesValidatingAdmissionPolicyBindingV1Config_basic(name, `["Warn", "Audit"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation_actions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation_actions.0", "Warn"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation_actions.1", "Audit"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesValidatingAdmissionPolicyBindingV1Destroy(s *terraform.State) error {
+	client, err := newAdmissionPolicyClient(testAccProvider.Meta(), validatingAdmissionPolicyBindingResource, "ValidatingAdmissionPolicyBinding")
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_validating_admission_policy_binding_v1" {
+			continue
+		}
+
+		err = client.Get(ctx, rs.Primary.ID, &admissionregistrationv1beta1.ValidatingAdmissionPolicyBinding{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("ValidatingAdmissionPolicyBinding still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesValidatingAdmissionPolicyBindingV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, err := newAdmissionPolicyClient(testAccProvider.Meta(), validatingAdmissionPolicyBindingResource, "ValidatingAdmissionPolicyBinding")
+		if err != nil {
+			return err
+		}
+
+		return client.Get(context.TODO(), rs.Primary.ID, &admissionregistrationv1beta1.ValidatingAdmissionPolicyBinding{})
+	}
+}
+
+func testAccKubernetesValidatingAdmissionPolicyBindingV1Config_basic(name, actions string) string {
+	return fmt.Sprintf(`resource "kubernetes_validating_admission_policy_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  spec {
+    param_kind {
+      api_version = "v1"
+      kind        = "ConfigMap"
+    }
+
+    match_constraints {
+      resource_rule {
+        api_groups   = ["apps"]
+        api_versions = ["v1"]
+        operations   = ["CREATE", "UPDATE"]
+        resources    = ["deployments"]
+      }
+    }
+
+    validation {
+      expression = "object.spec.replicas <= int(params.data.maxReplicas)"
+    }
+  }
+}
+
+resource "kubernetes_validating_admission_policy_binding_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  spec {
+    policy_name        = kubernetes_validating_admission_policy_v1.test.metadata[0].name
+    validation_actions = %[2]s
+
+    param_ref {
+      name      = "replica-limits"
+      namespace = "default"
+    }
+
+    match_resources {
+      namespace_selector {
+        match_labels = {
+          environment = "test"
+        }
+      }
+    }
+  }
+}
+`, name, actions)
+}
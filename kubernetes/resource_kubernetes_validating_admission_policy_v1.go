@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func resourceKubernetesValidatingAdmissionPolicyV1() *schema.Resource {
+	apiDoc := admissionregistrationv1beta1.ValidatingAdmissionPolicy{}.SwaggerDoc()
+	specDoc := admissionregistrationv1beta1.ValidatingAdmissionPolicySpec{}.SwaggerDoc()
+	paramKindDoc := admissionregistrationv1beta1.ParamKind{}.SwaggerDoc()
+	validationDoc := admissionregistrationv1beta1.Validation{}.SwaggerDoc()
+	auditAnnotationDoc := admissionregistrationv1beta1.AuditAnnotation{}.SwaggerDoc()
+	matchConditionDoc := admissionregistrationv1beta1.MatchCondition{}.SwaggerDoc()
+	variableDoc := admissionregistrationv1beta1.Variable{}.SwaggerDoc()
+	return &schema.Resource{
+		Description:   "Validating Admission Policy describes the definition of an [admission validation policy](https://kubernetes.io/docs/reference/access-authn-authz/validating-admission-policy/) that accepts or rejects an object without changing it, by evaluating CEL expressions in the API server.",
+		CreateContext: resourceKubernetesValidatingAdmissionPolicyV1Create,
+		ReadContext:   resourceKubernetesValidatingAdmissionPolicyV1Read,
+		UpdateContext: resourceKubernetesValidatingAdmissionPolicyV1Update,
+		DeleteContext: resourceKubernetesValidatingAdmissionPolicyV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("validating admission policy", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: apiDoc["spec"],
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"audit_annotation": {
+							Type:        schema.TypeList,
+							Description: specDoc["auditAnnotations"],
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Description: auditAnnotationDoc["key"],
+										Required:    true,
+									},
+									"value_expression": {
+										Type:        schema.TypeString,
+										Description: auditAnnotationDoc["valueExpression"],
+										Required:    true,
+									},
+								},
+							},
+						},
+						"failure_policy": {
+							Type:        schema.TypeString,
+							Description: specDoc["failurePolicy"],
+							Optional:    true,
+							Default:     string(admissionregistrationv1beta1.Fail),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(admissionregistrationv1beta1.Fail),
+								string(admissionregistrationv1beta1.Ignore),
+							}, false),
+						},
+						"match_condition": {
+							Type:        schema.TypeList,
+							Description: specDoc["matchConditions"],
+							Optional:    true,
+							MaxItems:    64,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expression": {
+										Type:        schema.TypeString,
+										Description: matchConditionDoc["expression"],
+										Required:    true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: matchConditionDoc["name"],
+										Required:    true,
+									},
+								},
+							},
+						},
+						"match_constraints": {
+							Type:        schema.TypeList,
+							Description: specDoc["matchConstraints"],
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: matchResourcesFields(),
+							},
+						},
+						"param_kind": {
+							Type:        schema.TypeList,
+							Description: specDoc["paramKind"],
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"api_version": {
+										Type:        schema.TypeString,
+										Description: paramKindDoc["apiVersion"],
+										Required:    true,
+									},
+									"kind": {
+										Type:        schema.TypeString,
+										Description: paramKindDoc["kind"],
+										Required:    true,
+									},
+								},
+							},
+						},
+						"validation": {
+							Type:        schema.TypeList,
+							Description: specDoc["validations"],
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expression": {
+										Type:        schema.TypeString,
+										Description: validationDoc["expression"],
+										Required:    true,
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Description: validationDoc["message"],
+										Optional:    true,
+									},
+									"message_expression": {
+										Type:        schema.TypeString,
+										Description: validationDoc["messageExpression"],
+										Optional:    true,
+									},
+									"reason": {
+										Type:        schema.TypeString,
+										Description: validationDoc["reason"],
+										Optional:    true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(metav1.StatusReasonUnauthorized),
+											string(metav1.StatusReasonForbidden),
+											string(metav1.StatusReasonInvalid),
+											string(metav1.StatusReasonRequestEntityTooLarge),
+										}, false),
+									},
+								},
+							},
+						},
+						"variable": {
+							Type:        schema.TypeList,
+							Description: specDoc["variables"],
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expression": {
+										Type:        schema.TypeString,
+										Description: variableDoc["expression"],
+										Required:    true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: variableDoc["name"],
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesValidatingAdmissionPolicyV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newAdmissionPolicyClient(meta, validatingAdmissionPolicyResource, "ValidatingAdmissionPolicy")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy := admissionregistrationv1beta1.ValidatingAdmissionPolicy{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandValidatingAdmissionPolicySpec(d.Get("spec").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new ValidatingAdmissionPolicy: %#v", policy)
+
+	res := &admissionregistrationv1beta1.ValidatingAdmissionPolicy{}
+	err = client.Create(ctx, &policy, res)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Submitted new ValidatingAdmissionPolicy: %#v", res)
+
+	d.SetId(res.Name)
+
+	return resourceKubernetesValidatingAdmissionPolicyV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesValidatingAdmissionPolicyV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newAdmissionPolicyClient(meta, validatingAdmissionPolicyResource, "ValidatingAdmissionPolicy")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading ValidatingAdmissionPolicy %s", name)
+	policy := &admissionregistrationv1beta1.ValidatingAdmissionPolicy{}
+	err = client.Get(ctx, name, policy)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[INFO] ValidatingAdmissionPolicy %s not found", name)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("metadata", flattenMetadata(policy.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("spec", flattenValidatingAdmissionPolicySpec(policy.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesValidatingAdmissionPolicyV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newAdmissionPolicyClient(meta, validatingAdmissionPolicyResource, "ValidatingAdmissionPolicy")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandValidatingAdmissionPolicySpec(d.Get("spec").([]interface{})),
+		})
+	}
+
+	name := d.Id()
+	res := &admissionregistrationv1beta1.ValidatingAdmissionPolicy{}
+	err = client.Patch(ctx, name, ops, res)
+	if err != nil {
+		return diag.Errorf("Failed to update ValidatingAdmissionPolicy: %s", err)
+	}
+
+	log.Printf("[INFO] Submitted updated ValidatingAdmissionPolicy: %#v", res)
+
+	return resourceKubernetesValidatingAdmissionPolicyV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesValidatingAdmissionPolicyV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newAdmissionPolicyClient(meta, validatingAdmissionPolicyResource, "ValidatingAdmissionPolicy")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting ValidatingAdmissionPolicy: %#v", name)
+	err = client.Delete(ctx, name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] ValidatingAdmissionPolicy %#v is deleted", name)
+
+	d.SetId("")
+	return nil
+}
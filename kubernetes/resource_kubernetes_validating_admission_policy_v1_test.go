@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestAccKubernetesValidatingAdmissionPolicyV1_basic(t *testing.T) {
+	name := fmt.Sprintf("acc-test-%v.terraform.io", acctest.RandString(10))
+	resourceName := "kubernetes_validating_admission_policy_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.30.0")
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesValidatingAdmissionPolicyV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesValidatingAdmissionPolicyV1Config_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesValidatingAdmissionPolicyV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.failure_policy", "Fail"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.match_constraints.0.match_policy", "Equivalent"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.match_constraints.0.resource_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.match_constraints.0.resource_rule.0.api_groups.0", "apps"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.match_constraints.0.resource_rule.0.operations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.match_constraints.0.resource_rule.0.resources.0", "deployments"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation.0.expression", "object.spec.replicas <= 5"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation.0.message", "at most 5 replicas"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.param_kind.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesValidatingAdmissionPolicyV1Config_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.failure_policy", "Ignore"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.param_kind.0.api_version", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.param_kind.0.kind", "ConfigMap"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.match_constraints.0.namespace_selector.0.match_labels.environment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.variable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.variable.0.name", "max_replicas"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation.0.message_expression", "'at most ' + string(variables.max_replicas) + ' replicas'"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation.0.reason", "Invalid"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.match_condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.match_condition.0.name", "exclude-system"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.audit_annotation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.audit_annotation.0.key", "replicas"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesValidatingAdmissionPolicyV1Destroy(s *terraform.State) error {
+	client, err := newAdmissionPolicyClient(testAccProvider.Meta(), validatingAdmissionPolicyResource, "ValidatingAdmissionPolicy")
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_validating_admission_policy_v1" {
+			continue
+		}
+
+		err = client.Get(ctx, rs.Primary.ID, &admissionregistrationv1beta1.ValidatingAdmissionPolicy{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("ValidatingAdmissionPolicy still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesValidatingAdmissionPolicyV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, err := newAdmissionPolicyClient(testAccProvider.Meta(), validatingAdmissionPolicyResource, "ValidatingAdmissionPolicy")
+		if err != nil {
+			return err
+		}
+
+		return client.Get(context.TODO(), rs.Primary.ID, &admissionregistrationv1beta1.ValidatingAdmissionPolicy{})
+	}
+}
+
+func testAccKubernetesValidatingAdmissionPolicyV1Config_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_validating_admission_policy_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    match_constraints {
+      resource_rule {
+        api_groups   = ["apps"]
+        api_versions = ["v1"]
+        operations   = ["CREATE", "UPDATE"]
+        resources    = ["deployments"]
+      }
+    }
+
+    validation {
+      expression = "object.spec.replicas <= 5"
+      message    = "at most 5 replicas"
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesValidatingAdmissionPolicyV1Config_modified(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_validating_admission_policy_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    failure_policy = "Ignore"
+
+    param_kind {
+      api_version = "v1"
+      kind        = "ConfigMap"
+    }
+
+    match_constraints {
+      namespace_selector {
+        match_labels = {
+          environment = "test"
+        }
+      }
+
+      resource_rule {
+        api_groups   = ["apps"]
+        api_versions = ["v1"]
+        operations   = ["CREATE", "UPDATE"]
+        resources    = ["deployments"]
+      }
+    }
+
+    match_condition {
+      name       = "exclude-system"
+      expression = "!object.metadata.namespace.startsWith('kube-')"
+    }
+
+    variable {
+      name       = "max_replicas"
+      expression = "int(params.data.maxReplicas)"
+    }
+
+    validation {
+      expression         = "object.spec.replicas <= variables.max_replicas"
+      message_expression = "'at most ' + string(variables.max_replicas) + ' replicas'"
+      reason             = "Invalid"
+    }
+
+    audit_annotation {
+      key              = "replicas"
+      value_expression = "string(object.spec.replicas)"
+    }
+  }
+}
+`, name)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKubernetesVolumeAttributesClassV1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "VolumeAttributesClass describes a set of mutable attributes of a volume, such as IOPS and throughput tiers, applied by its CSI driver. A PersistentVolumeClaim selects one with `volume_attributes_class_name`, and can be moved to another class without recreating the volume.",
+		CreateContext: resourceKubernetesVolumeAttributesClassV1Create,
+		ReadContext:   resourceKubernetesVolumeAttributesClassV1Read,
+		UpdateContext: resourceKubernetesVolumeAttributesClassV1Update,
+		DeleteContext: resourceKubernetesVolumeAttributesClassV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("volume attributes class", true),
+			"driver_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the CSI driver which applies the attributes of this class. Cannot be updated.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"parameters": {
+				Type:        schema.TypeMap,
+				Description: "Driver specific attributes of the volumes of this class, such as `iops` or `throughput`. Cannot be updated.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceKubernetesVolumeAttributesClassV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	class := map[string]interface{}{
+		"driverName": d.Get("driver_name"),
+	}
+	if v := d.Get("parameters").(map[string]interface{}); len(v) > 0 {
+		class["parameters"] = v
+	}
+	k, err := volumeAttributesClassKind(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = createCustomResourceObject(ctx, d, meta, k, class)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesVolumeAttributesClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeAttributesClassV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	k, err := volumeAttributesClassKind(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	obj, err := readCustomResourceObject(ctx, d, meta, k)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if obj == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("driver_name", unstructuredString(obj.Object["driverName"]))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("parameters", unstructuredMap(obj.Object["parameters"]))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesVolumeAttributesClassV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Both the driver and the parameters are immutable, so only the metadata is patched
+	k, err := volumeAttributesClassKind(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = updateCustomResourceObject(ctx, d, meta, k, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesVolumeAttributesClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeAttributesClassV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	k, err := volumeAttributesClassKind(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// The class is protected by a finalizer until no claim or volume refers to it anymore
+	err = deleteCustomResourceObject(ctx, d, meta, k)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesVolumeAttributesClassV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_volume_attributes_class_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.34.0")
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCustomResourceObjectDestroy(volumeAttributesClassV1Kind, "kubernetes_volume_attributes_class_v1"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesVolumeAttributesClassV1Config(name, "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(volumeAttributesClassV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "driver_name", "hostpath.csi.k8s.io"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.iops", "3000"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.throughput", "125"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesVolumeAttributesClassV1Config(name, "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(volumeAttributesClassV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.TestLabelOne", "two"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesVolumeAttributesClassV1Config(name, label string) string {
+	return fmt.Sprintf(`resource "kubernetes_volume_attributes_class_v1" "test" {
+  metadata {
+    name = %q
+
+    labels = {
+      TestLabelOne = %q
+    }
+  }
+
+  driver_name = "hostpath.csi.k8s.io"
+
+  parameters = {
+    iops       = "3000"
+    throughput = "125"
+  }
+}
+`, name, label)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKubernetesVolumeSnapshotClassV1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "VolumeSnapshotClass describes the parameters used by the storage system when creating a volume snapshot, and the CSI driver which creates it. It plays the same role for volume snapshots as a StorageClass does for volumes.",
+		CreateContext: resourceKubernetesVolumeSnapshotClassV1Create,
+		ReadContext:   resourceKubernetesVolumeSnapshotClassV1Read,
+		UpdateContext: resourceKubernetesVolumeSnapshotClassV1Update,
+		DeleteContext: resourceKubernetesVolumeSnapshotClassV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("volume snapshot class", true),
+			"driver": {
+				Type:        schema.TypeString,
+				Description: "The name of the CSI driver which creates the snapshots of this class. Cannot be updated.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"parameters": {
+				Type:        schema.TypeMap,
+				Description: "Driver specific parameters used when creating the snapshots of this class. Cannot be updated.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"snapshot_deletion_policy": volumeSnapshotDeletionPolicySchema("The `deletionPolicy` of the class: whether the VolumeSnapshotContent of a snapshot of this class, and the snapshot in the storage system, are deleted along with the VolumeSnapshot. One of `Delete` or `Retain`."),
+		},
+	}
+}
+
+func resourceKubernetesVolumeSnapshotClassV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	class := map[string]interface{}{
+		"driver":         d.Get("driver"),
+		"deletionPolicy": d.Get("snapshot_deletion_policy"),
+	}
+	if v := d.Get("parameters").(map[string]interface{}); len(v) > 0 {
+		class["parameters"] = v
+	}
+	err := createCustomResourceObject(ctx, d, meta, volumeSnapshotClassV1Kind, class)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesVolumeSnapshotClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeSnapshotClassV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	obj, err := readCustomResourceObject(ctx, d, meta, volumeSnapshotClassV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if obj == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("driver", unstructuredString(obj.Object["driver"]))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("parameters", unstructuredMap(obj.Object["parameters"]))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("snapshot_deletion_policy", unstructuredString(obj.Object["deletionPolicy"]))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesVolumeSnapshotClassV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var ops PatchOperations
+	if d.HasChange("snapshot_deletion_policy") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/deletionPolicy",
+			Value: d.Get("snapshot_deletion_policy"),
+		})
+	}
+	err := updateCustomResourceObject(ctx, d, meta, volumeSnapshotClassV1Kind, ops)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesVolumeSnapshotClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeSnapshotClassV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := deleteCustomResourceObject(ctx, d, meta, volumeSnapshotClassV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
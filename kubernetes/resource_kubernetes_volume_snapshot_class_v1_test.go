@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesVolumeSnapshotClassV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_volume_snapshot_class_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfCRDNotInstalled(t, volumeSnapshotClassV1Kind)
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCustomResourceObjectDestroy(volumeSnapshotClassV1Kind, "kubernetes_volume_snapshot_class_v1"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesVolumeSnapshotClassV1Config(name, "Delete"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(volumeSnapshotClassV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "driver", "hostpath.csi.k8s.io"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.type", "full"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_deletion_policy", "Delete"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesVolumeSnapshotClassV1Config(name, "Retain"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(volumeSnapshotClassV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_deletion_policy", "Retain"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesVolumeSnapshotClassV1Config(name, deletionPolicy string) string {
+	return fmt.Sprintf(`resource "kubernetes_volume_snapshot_class_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  driver                   = "hostpath.csi.k8s.io"
+  snapshot_deletion_policy = %q
+
+  parameters = {
+    type = "full"
+  }
+}
+`, name, deletionPolicy)
+}
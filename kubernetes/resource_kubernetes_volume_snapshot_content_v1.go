@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func resourceKubernetesVolumeSnapshotContentV1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "VolumeSnapshotContent represents the actual snapshot in the storage system. It is usually provisioned by the snapshot controller for a VolumeSnapshot, but can be created to import a snapshot taken outside of the cluster, in which case a VolumeSnapshot referencing it by name binds to it.",
+		CreateContext: resourceKubernetesVolumeSnapshotContentV1Create,
+		ReadContext:   resourceKubernetesVolumeSnapshotContentV1Read,
+		UpdateContext: resourceKubernetesVolumeSnapshotContentV1Update,
+		DeleteContext: resourceKubernetesVolumeSnapshotContentV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("volume snapshot content", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the properties of the snapshot in the storage system.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"deletion_policy": volumeSnapshotDeletionPolicySchema("Whether the snapshot in the storage system is deleted along with the content when its VolumeSnapshot is deleted. One of `Delete` or `Retain`."),
+						"driver": {
+							Type:        schema.TypeString,
+							Description: "The name of the CSI driver which manages the snapshot in the storage system. Cannot be updated.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"source": {
+							Type:        schema.TypeList,
+							Description: "Whether the snapshot should be dynamically taken of a volume or already exists in the storage system. Cannot be updated.",
+							Required:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"snapshot_handle": {
+										Type:         schema.TypeString,
+										Description:  "The CSI handle of a snapshot which already exists in the storage system.",
+										Optional:     true,
+										ForceNew:     true,
+										ExactlyOneOf: []string{"spec.0.source.0.snapshot_handle", "spec.0.source.0.volume_handle"},
+									},
+									"volume_handle": {
+										Type:         schema.TypeString,
+										Description:  "The CSI handle of the volume to dynamically take the snapshot of.",
+										Optional:     true,
+										ForceNew:     true,
+										ExactlyOneOf: []string{"spec.0.source.0.snapshot_handle", "spec.0.source.0.volume_handle"},
+									},
+								},
+							},
+						},
+						"source_volume_mode": {
+							Type:         schema.TypeString,
+							Description:  "The mode of the volume the snapshot was taken of, either `Block` or `Filesystem`. Cannot be updated.",
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Block", "Filesystem"}, false),
+						},
+						"volume_snapshot_class_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the VolumeSnapshotClass from which the snapshot was or will be created.",
+							Optional:    true,
+						},
+						"volume_snapshot_ref": {
+							Type:        schema.TypeList,
+							Description: "The VolumeSnapshot the content is bound to. Cannot be updated.",
+							Required:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "The name of the VolumeSnapshot.",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"namespace": {
+										Type:        schema.TypeString,
+										Description: "The namespace of the VolumeSnapshot.",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"uid": {
+										Type:        schema.TypeString,
+										Description: "The UID of the VolumeSnapshot, set by the snapshot controller once the content is bound.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"creation_time": {
+							Type:        schema.TypeString,
+							Description: "The time the snapshot was taken by the storage system.",
+							Computed:    true,
+						},
+						"error": volumeSnapshotErrorSchema(),
+						"ready_to_use": {
+							Type:        schema.TypeBool,
+							Description: "Whether the snapshot is ready to be used to restore a volume.",
+							Computed:    true,
+						},
+						"restore_size": {
+							Type:        schema.TypeInt,
+							Description: "The minimum size in bytes of a volume restored from the snapshot.",
+							Computed:    true,
+						},
+						"snapshot_handle": {
+							Type:        schema.TypeString,
+							Description: "The CSI handle of the snapshot in the storage system.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"wait_for_ready_to_use": volumeSnapshotWaitForReadyToUseSchema("Terraform will wait for the snapshot controller to report the snapshot content as ready to use before considering the resource created."),
+		},
+	}
+}
+
+func resourceKubernetesVolumeSnapshotContentV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec := expandVolumeSnapshotContentV1Spec(d.Get("spec").([]interface{}))
+	err := createCustomResourceObject(ctx, d, meta, volumeSnapshotContentV1Kind, map[string]interface{}{"spec": spec})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_ready_to_use").(bool) {
+		err = waitForCustomResource(ctx, d, meta, volumeSnapshotContentV1Kind, schema.TimeoutCreate, "ready to use", volumeSnapshotReadyToUse)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesVolumeSnapshotContentV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeSnapshotContentV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	obj, err := readCustomResourceObject(ctx, d, meta, volumeSnapshotContentV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if obj == nil {
+		d.SetId("")
+		return nil
+	}
+
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	err = d.Set("spec", flattenVolumeSnapshotContentV1Spec(spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	status, _, _ := unstructured.NestedMap(obj.Object, "status")
+	err = d.Set("status", flattenVolumeSnapshotContentV1Status(status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesVolumeSnapshotContentV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The rest of the spec is immutable, and the controller fills in the UID of the bound snapshot,
+	// so only the mutable fields are patched rather than the whole spec
+	var ops PatchOperations
+	if d.HasChange("spec.0.deletion_policy") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec/deletionPolicy",
+			Value: d.Get("spec.0.deletion_policy"),
+		})
+	}
+	if d.HasChange("spec.0.volume_snapshot_class_name") {
+		ops = append(ops, &AddOperation{
+			Path:  "/spec/volumeSnapshotClassName",
+			Value: d.Get("spec.0.volume_snapshot_class_name"),
+		})
+	}
+	err := updateCustomResourceObject(ctx, d, meta, volumeSnapshotContentV1Kind, ops)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesVolumeSnapshotContentV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeSnapshotContentV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := deleteCustomResourceObject(ctx, d, meta, volumeSnapshotContentV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
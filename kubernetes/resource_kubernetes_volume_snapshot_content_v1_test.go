@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesVolumeSnapshotContentV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_volume_snapshot_content_v1.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfCRDNotInstalled(t, volumeSnapshotContentV1Kind)
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCustomResourceObjectDestroy(volumeSnapshotContentV1Kind, "kubernetes_volume_snapshot_content_v1"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesVolumeSnapshotContentV1Config(name, "Retain"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(volumeSnapshotContentV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.deletion_policy", "Retain"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.driver", "hostpath.csi.k8s.io"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.source.0.snapshot_handle", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.volume_snapshot_ref.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.volume_snapshot_ref.0.namespace", "default"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_ready_to_use"},
+			},
+			{
+				Config: testAccKubernetesVolumeSnapshotContentV1Config(name, "Delete"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(volumeSnapshotContentV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.deletion_policy", "Delete"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesVolumeSnapshotContentV1Config(name, deletionPolicy string) string {
+	return fmt.Sprintf(`resource "kubernetes_volume_snapshot_content_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  spec {
+    deletion_policy = %[2]q
+    driver          = "hostpath.csi.k8s.io"
+
+    source {
+      snapshot_handle = %[1]q
+    }
+
+    volume_snapshot_ref {
+      name      = %[1]q
+      namespace = "default"
+    }
+  }
+}
+`, name, deletionPolicy)
+}
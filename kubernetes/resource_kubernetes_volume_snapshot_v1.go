@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func resourceKubernetesVolumeSnapshotV1() *schema.Resource {
+	return &schema.Resource{
+		Description:   "VolumeSnapshot is a user's request for a snapshot of a volume, either taken of a PersistentVolumeClaim or pre-provisioned as a VolumeSnapshotContent. A PersistentVolumeClaim can be restored from it by setting it as the claim's `data_source`.",
+		CreateContext: resourceKubernetesVolumeSnapshotV1Create,
+		ReadContext:   resourceKubernetesVolumeSnapshotV1Read,
+		UpdateContext: resourceKubernetesVolumeSnapshotV1Update,
+		DeleteContext: resourceKubernetesVolumeSnapshotV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("volume snapshot", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the desired characteristics of the snapshot requested by the user.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:        schema.TypeList,
+							Description: "Where the snapshot is taken from. Cannot be updated.",
+							Required:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"persistent_volume_claim_name": {
+										Type:         schema.TypeString,
+										Description:  "The name of the PersistentVolumeClaim, in the namespace of the snapshot, to dynamically take the snapshot of.",
+										Optional:     true,
+										ForceNew:     true,
+										ExactlyOneOf: []string{"spec.0.source.0.persistent_volume_claim_name", "spec.0.source.0.volume_snapshot_content_name"},
+									},
+									"volume_snapshot_content_name": {
+										Type:         schema.TypeString,
+										Description:  "The name of a pre-provisioned VolumeSnapshotContent representing an existing snapshot.",
+										Optional:     true,
+										ForceNew:     true,
+										ExactlyOneOf: []string{"spec.0.source.0.persistent_volume_claim_name", "spec.0.source.0.volume_snapshot_content_name"},
+									},
+								},
+							},
+						},
+						"volume_snapshot_class_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the VolumeSnapshotClass requested by the snapshot. Defaults to the default VolumeSnapshotClass of the CSI driver of the volume, if there is one.",
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bound_volume_snapshot_content_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the VolumeSnapshotContent the snapshot is bound to.",
+							Computed:    true,
+						},
+						"creation_time": {
+							Type:        schema.TypeString,
+							Description: "The time the snapshot was taken by the storage system.",
+							Computed:    true,
+						},
+						"error": volumeSnapshotErrorSchema(),
+						"ready_to_use": {
+							Type:        schema.TypeBool,
+							Description: "Whether the snapshot is ready to be used to restore a volume.",
+							Computed:    true,
+						},
+						"restore_size": {
+							Type:        schema.TypeString,
+							Description: "The minimum size of a volume restored from the snapshot, such as `1Gi`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"wait_for_ready_to_use": volumeSnapshotWaitForReadyToUseSchema("Terraform will wait for the snapshot controller to report the snapshot as ready to use before considering the resource created."),
+		},
+	}
+}
+
+func resourceKubernetesVolumeSnapshotV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec := expandVolumeSnapshotV1Spec(d.Get("spec").([]interface{}))
+	err := createCustomResourceObject(ctx, d, meta, volumeSnapshotV1Kind, map[string]interface{}{"spec": spec})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_ready_to_use").(bool) {
+		err = waitForCustomResource(ctx, d, meta, volumeSnapshotV1Kind, schema.TimeoutCreate, "ready to use", volumeSnapshotReadyToUse)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesVolumeSnapshotV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeSnapshotV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	obj, err := readCustomResourceObject(ctx, d, meta, volumeSnapshotV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if obj == nil {
+		d.SetId("")
+		return nil
+	}
+
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	err = d.Set("spec", flattenVolumeSnapshotV1Spec(spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	status, _, _ := unstructured.NestedMap(obj.Object, "status")
+	err = d.Set("status", flattenVolumeSnapshotV1Status(status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesVolumeSnapshotV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The source of a snapshot is immutable, so only its class is patched
+	var ops PatchOperations
+	if d.HasChange("spec.0.volume_snapshot_class_name") {
+		ops = append(ops, &AddOperation{
+			Path:  "/spec/volumeSnapshotClassName",
+			Value: d.Get("spec.0.volume_snapshot_class_name"),
+		})
+	}
+	err := updateCustomResourceObject(ctx, d, meta, volumeSnapshotV1Kind, ops)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesVolumeSnapshotV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeSnapshotV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := deleteCustomResourceObject(ctx, d, meta, volumeSnapshotV1Kind)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesVolumeSnapshotV1_preProvisioned(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_volume_snapshot_v1.test"
+	claimName := "kubernetes_persistent_volume_claim_v1.restored"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfCRDNotInstalled(t, volumeSnapshotV1Kind)
+		},
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckKubernetesCustomResourceObjectDestroy(volumeSnapshotV1Kind, "kubernetes_volume_snapshot_v1"),
+			testAccCheckKubernetesPersistentVolumeClaimV1Destroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesVolumeSnapshotV1Config_preProvisioned(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCustomResourceObjectExists(volumeSnapshotV1Kind, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.namespace", "default"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.source.0.volume_snapshot_content_name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.source.0.persistent_volume_claim_name", ""),
+					resource.TestCheckResourceAttr(claimName, "spec.0.data_source.0.api_group", "snapshot.storage.k8s.io"),
+					resource.TestCheckResourceAttr(claimName, "spec.0.data_source.0.kind", "VolumeSnapshot"),
+					resource.TestCheckResourceAttr(claimName, "spec.0.data_source.0.name", name),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_ready_to_use"},
+			},
+		},
+	})
+}
+
+func testAccKubernetesVolumeSnapshotV1Config_preProvisioned(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_volume_snapshot_content_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  spec {
+    deletion_policy = "Retain"
+    driver          = "hostpath.csi.k8s.io"
+
+    source {
+      snapshot_handle = %[1]q
+    }
+
+    volume_snapshot_ref {
+      name      = %[1]q
+      namespace = "default"
+    }
+  }
+}
+
+resource "kubernetes_volume_snapshot_v1" "test" {
+  metadata {
+    name      = %[1]q
+    namespace = "default"
+  }
+
+  spec {
+    source {
+      volume_snapshot_content_name = kubernetes_volume_snapshot_content_v1.test.metadata.0.name
+    }
+  }
+}
+
+resource "kubernetes_persistent_volume_claim_v1" "restored" {
+  metadata {
+    name      = %[1]q
+    namespace = "default"
+  }
+
+  spec {
+    access_modes = ["ReadWriteOnce"]
+
+    resources {
+      requests = {
+        storage = "1Gi"
+      }
+    }
+
+    data_source {
+      api_group = "snapshot.storage.k8s.io"
+      kind      = "VolumeSnapshot"
+      name      = kubernetes_volume_snapshot_v1.test.metadata.0.name
+    }
+  }
+
+  wait_until_bound = false
+}
+`, name)
+}
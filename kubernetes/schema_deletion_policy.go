@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+)
+
+const (
+	deletionPolicyDelete  = "delete"
+	deletionPolicyRetain  = "retain"
+	deletionPolicyAbandon = "abandon"
+)
+
+// releasedAnnotation records when an object was left in the cluster by the "retain" deletion policy,
+// the same way as kubernetes_manifest
+const releasedAnnotation = "terraform.io/released-at"
+
+// deletionPolicyExcludedResources are resources which patch or request objects rather than own them,
+// so leaving their object behind on destroy has no meaning
+var deletionPolicyExcludedResources = map[string]bool{
+	"kubernetes_annotations":                    true,
+	"kubernetes_labels":                         true,
+	"kubernetes_config_map_v1_data":             true,
+	"kubernetes_env":                            true,
+	"kubernetes_node_taint":                     true,
+	"kubernetes_default_service_account":        true,
+	"kubernetes_default_service_account_v1":     true,
+	"kubernetes_certificate_signing_request":    true,
+	"kubernetes_certificate_signing_request_v1": true,
+	"kubernetes_token_request_v1":               true,
+}
+
+func deletionPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "What happens to the object in the cluster when the resource is destroyed. One of \"delete\", \"retain\" or \"abandon\". With \"retain\" the object is left in the cluster and annotated as released by Terraform, with \"abandon\" it is left untouched. Defaults to \"delete\".",
+		ValidateFunc: validation.StringInSlice([]string{deletionPolicyDelete, deletionPolicyRetain, deletionPolicyAbandon}, false),
+	}
+}
+
+// withDeletionPolicy adds the "deletion_policy" attribute to a resource, skipping
+// the delete operation of the resource when the object is to be left in the cluster
+func withDeletionPolicy(name string, r *schema.Resource) {
+	r.Schema["deletion_policy"] = deletionPolicySchema()
+
+	wrap := func(del schema.DeleteContextFunc) schema.DeleteContextFunc {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if p, ok := d.Get("deletion_policy").(string); ok && p != "" && p != deletionPolicyDelete {
+				if p == deletionPolicyRetain {
+					if err := markReleased(ctx, meta, name, d.Id()); err != nil {
+						return diag.Errorf("Failed to annotate retained resource %q: %s", d.Id(), err)
+					}
+				}
+				log.Printf("[INFO] Leaving %q in the cluster as its deletion_policy is %q", d.Id(), p)
+				d.SetId("")
+				return nil
+			}
+			return del(ctx, d, meta)
+		}
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = wrap(r.DeleteContext)
+	}
+	if r.DeleteWithoutTimeout != nil {
+		r.DeleteWithoutTimeout = wrap(r.DeleteWithoutTimeout)
+	}
+}
+
+var deletionPolicyVersionSuffix = regexp.MustCompile(`_v[0-9]+((alpha|beta)[0-9]+)?$`)
+
+// deletionPolicyResource returns the singular name of the Kubernetes resource managed by a
+// Terraform resource type, e.g. "clusterrolebinding" for "kubernetes_cluster_role_binding_v1"
+func deletionPolicyResource(typeName string) string {
+	r := strings.TrimPrefix(typeName, "kubernetes_")
+	r = deletionPolicyVersionSuffix.ReplaceAllString(r, "")
+	return strings.ReplaceAll(r, "_", "")
+}
+
+// markReleased annotates the object of a resource retained on destroy with the time Terraform released it
+func markReleased(ctx context.Context, m interface{}, typeName, id string) error {
+	dc, err := m.(KubeClientsets).DiscoveryClient()
+	if err != nil {
+		return err
+	}
+	agr, err := restmapper.GetAPIGroupResources(dc)
+	if err != nil {
+		return err
+	}
+	restMapper := restmapper.NewDiscoveryRESTMapper(agr)
+	gvk, err := restMapper.KindFor(apimachineryschema.GroupVersionResource{Resource: deletionPolicyResource(typeName)})
+	if err != nil {
+		return err
+	}
+	mapping, err := restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return err
+	}
+
+	conn, err := m.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return err
+	}
+	var r dynamic.ResourceInterface
+	name := id
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		var namespace string
+		namespace, name, err = idParts(id)
+		if err != nil {
+			return err
+		}
+		r = conn.Resource(mapping.Resource).Namespace(namespace)
+	} else {
+		r = conn.Resource(mapping.Resource)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				releasedAnnotation: time.Now().UTC().Format(time.RFC3339),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Annotating %s %q as released", mapping.Resource, id)
+	_, err = r.Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: defaultFieldManagerName})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
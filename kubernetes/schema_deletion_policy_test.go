@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestWithDeletionPolicy(t *testing.T) {
+	cases := map[string]struct {
+		policy   string
+		deleted  bool
+		released bool
+	}{
+		"default": {policy: "", deleted: true},
+		"delete":  {policy: deletionPolicyDelete, deleted: true},
+		"retain":  {policy: deletionPolicyRetain, deleted: false, released: true},
+		"abandon": {policy: deletionPolicyAbandon, deleted: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {Type: schema.TypeString, Optional: true},
+				},
+				DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+					deleted = true
+					return nil
+				},
+			}
+			withDeletionPolicy("kubernetes_config_map_v1", r)
+
+			cm := &unstructured.Unstructured{}
+			cm.SetAPIVersion("v1")
+			cm.SetKind("ConfigMap")
+			cm.SetName("test")
+			cm.SetNamespace("default")
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), cm)
+			meta := providerMetadata{
+				dynamicClient: dynamicClient,
+				discoveryClient: &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{Resources: []*metav1.APIResourceList{{
+					GroupVersion: "v1",
+					APIResources: []metav1.APIResource{
+						{Name: "configmaps", SingularName: "configmap", Namespaced: true, Kind: "ConfigMap"},
+					},
+				}}}},
+			}
+
+			raw := map[string]interface{}{"name": "test"}
+			if tc.policy != "" {
+				raw["deletion_policy"] = tc.policy
+			}
+			d := schema.TestResourceDataRaw(t, r.Schema, raw)
+			d.SetId("default/test")
+			if diags := r.DeleteContext(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if deleted != tc.deleted {
+				t.Errorf("expected delete to be called: %t, got: %t", tc.deleted, deleted)
+			}
+			if !tc.deleted && d.Id() != "" {
+				t.Errorf("expected resource to be removed from state, got ID %q", d.Id())
+			}
+
+			live, err := dynamicClient.Resource(cm.GroupVersionKind().GroupVersion().WithResource("configmaps")).Namespace("default").Get(context.Background(), "test", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := live.GetAnnotations()[releasedAnnotation]; ok != tc.released {
+				t.Errorf("expected %s annotation: %t, got: %t", releasedAnnotation, tc.released, ok)
+			}
+		})
+	}
+}
+
+func TestDeletionPolicyResource(t *testing.T) {
+	samples := map[string]string{
+		"kubernetes_config_map":                        "configmap",
+		"kubernetes_cluster_role_binding_v1":           "clusterrolebinding",
+		"kubernetes_endpoints_v1":                      "endpoints",
+		"kubernetes_horizontal_pod_autoscaler_v2":      "horizontalpodautoscaler",
+		"kubernetes_horizontal_pod_autoscaler_v2beta2": "horizontalpodautoscaler",
+		"kubernetes_api_service_v1":                    "apiservice",
+		"kubernetes_http_route_v1":                     "httproute",
+	}
+	for typeName, resource := range samples {
+		if r := deletionPolicyResource(typeName); r != resource {
+			t.Errorf("unexpected resource for %s: %s", typeName, r)
+		}
+	}
+}
+
+func TestProviderDeletionPolicy(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		_, ok := r.Schema["deletion_policy"]
+		if ok == deletionPolicyExcludedResources[name] {
+			t.Errorf("%s: unexpected presence of deletion_policy: %t", name, ok)
+		}
+	}
+}
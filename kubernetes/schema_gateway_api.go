@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func gatewayAPIWaitForAcceptedSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: description,
+	}
+}
+
+func gatewayAPIConditionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Conditions describe the current state of the resource, as reported by its controller.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"message": {
+					Type:        schema.TypeString,
+					Description: "A human readable message indicating details about the transition.",
+					Computed:    true,
+				},
+				"reason": {
+					Type:        schema.TypeString,
+					Description: "A programmatic identifier indicating the reason for the condition's last transition.",
+					Computed:    true,
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Description: "Status of the condition, one of `True`, `False` or `Unknown`.",
+					Computed:    true,
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Description: "Type of the condition, such as `Accepted`.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func gatewayAPIParentReferenceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"group": {
+			Type:        schema.TypeString,
+			Description: "Group of the referent. Defaults to `gateway.networking.k8s.io`.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Description: "Kind of the referent. Defaults to `Gateway`.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the referent.",
+			Required:    true,
+		},
+		"namespace": {
+			Type:        schema.TypeString,
+			Description: "Namespace of the referent. Defaults to the namespace of the route.",
+			Optional:    true,
+		},
+		"port": {
+			Type:         schema.TypeInt,
+			Description:  "Network port this route targets. When set, the route only attaches to listeners of the parent on that port.",
+			Optional:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"section_name": {
+			Type:        schema.TypeString,
+			Description: "Name of a section within the parent, such as a listener of a Gateway. When set, the route only attaches to that section.",
+			Optional:    true,
+		},
+	}
+}
+
+func gatewayAPIBackendReferenceFields(weighted bool) map[string]*schema.Schema {
+	fields := map[string]*schema.Schema{
+		"group": {
+			Type:        schema.TypeString,
+			Description: "Group of the referent. Defaults to the core API group.",
+			Optional:    true,
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Description: "Kind of the referent. Defaults to `Service`.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the referent.",
+			Required:    true,
+		},
+		"namespace": {
+			Type:        schema.TypeString,
+			Description: "Namespace of the referent. Defaults to the namespace of the route. A reference to another namespace must be allowed by a ReferenceGrant in that namespace.",
+			Optional:    true,
+		},
+		"port": {
+			Type:         schema.TypeInt,
+			Description:  "Destination port number of the referent. Required when the referent is a Service.",
+			Optional:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+	}
+	if weighted {
+		fields["weight"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Description:  "Proportion of requests forwarded to this backend, relative to the other backends of the rule. A weight of 0 stops traffic to the backend.",
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(0, 1000000),
+		}
+	}
+	return fields
+}
+
+func gatewayAPIHeaderMatchFields(types []string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the header to match, compared case-insensitively.",
+			Required:    true,
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Description:  "How to match against the value of the header. Defaults to `Exact`.",
+			Optional:     true,
+			Default:      "Exact",
+			ValidateFunc: validation.StringInSlice(types, false),
+		},
+		"value": {
+			Type:        schema.TypeString,
+			Description: "Value of the header to match.",
+			Required:    true,
+		},
+	}
+}
+
+func gatewayAPIHeaderModifierSchema(description string) *schema.Schema {
+	header := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the header.",
+				Required:    true,
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Description: "Value of the header.",
+				Required:    true,
+			},
+		},
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"add": {
+					Type:        schema.TypeList,
+					Description: "Headers to add to the existing values of the header.",
+					Optional:    true,
+					Elem:        header,
+				},
+				"remove": {
+					Type:        schema.TypeList,
+					Description: "Names of the headers to remove.",
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"set": {
+					Type:        schema.TypeList,
+					Description: "Headers to overwrite.",
+					Optional:    true,
+					Elem:        header,
+				},
+			},
+		},
+	}
+}
+
+func gatewayAPIRequestMirrorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Mirrors requests to another backend. Responses from the mirror are ignored.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"backend_ref": {
+					Type:        schema.TypeList,
+					Description: "The backend where mirrored requests are sent.",
+					Required:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: gatewayAPIBackendReferenceFields(false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func gatewayAPIRouteSpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"hostnames": {
+			Type:        schema.TypeList,
+			Description: "Hostnames to match against the Host header, or the authority of a gRPC request, before the rules are evaluated.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"parent_ref": {
+			Type:        schema.TypeList,
+			Description: "The resources, usually Gateways, that this route wants to be attached to.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: gatewayAPIParentReferenceFields(),
+			},
+		},
+	}
+}
+
+func gatewayAPIRouteStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"parent": {
+					Type:        schema.TypeList,
+					Description: "The status of the route with respect to each of its parents.",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"condition": gatewayAPIConditionSchema(),
+							"controller_name": {
+								Type:        schema.TypeString,
+								Description: "The controller that wrote this status.",
+								Computed:    true,
+							},
+							"name": {
+								Type:        schema.TypeString,
+								Description: "Name of the parent.",
+								Computed:    true,
+							},
+							"namespace": {
+								Type:        schema.TypeString,
+								Description: "Namespace of the parent.",
+								Computed:    true,
+							},
+							"section_name": {
+								Type:        schema.TypeString,
+								Description: "Section of the parent the status is for.",
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
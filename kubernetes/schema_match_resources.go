@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+)
+
+func namedRuleWithOperationsFields() map[string]*schema.Schema {
+	apiDoc := admissionregistrationv1beta1.NamedRuleWithOperations{}.SwaggerDoc()
+	fields := ruleWithOperationsFields()
+	fields["resource_names"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: apiDoc["resourceNames"],
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	return fields
+}
+
+func matchResourcesFields() map[string]*schema.Schema {
+	apiDoc := admissionregistrationv1beta1.MatchResources{}.SwaggerDoc()
+	return map[string]*schema.Schema{
+		"exclude_resource_rule": {
+			Type:        schema.TypeList,
+			Description: apiDoc["excludeResourceRules"],
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: namedRuleWithOperationsFields(),
+			},
+		},
+		"match_policy": {
+			Type:        schema.TypeString,
+			Description: apiDoc["matchPolicy"],
+			Optional:    true,
+			Default:     string(admissionregistrationv1beta1.Equivalent),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(admissionregistrationv1beta1.Equivalent),
+				string(admissionregistrationv1beta1.Exact),
+			}, false),
+		},
+		"namespace_selector": {
+			Type:        schema.TypeList,
+			Description: apiDoc["namespaceSelector"],
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: labelSelectorFields(true),
+			},
+		},
+		"object_selector": {
+			Type:        schema.TypeList,
+			Description: apiDoc["objectSelector"],
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: labelSelectorFields(true),
+			},
+		},
+		"resource_rule": {
+			Type:        schema.TypeList,
+			Description: apiDoc["resourceRules"],
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: namedRuleWithOperationsFields(),
+			},
+		},
+	}
+}
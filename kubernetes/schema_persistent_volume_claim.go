@@ -42,6 +42,28 @@ func persistentVolumeClaimSpecFields() map[string]*schema.Schema {
 			},
 			Set: schema.HashString,
 		},
+		"data_source": {
+			Type:        schema.TypeList,
+			Description: "An existing object to populate the volume from, such as a VolumeSnapshot or another PersistentVolumeClaim. When set, `data_source_ref` is set to the same object by the API server.",
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: persistentVolumeClaimDataSourceFields(false),
+			},
+		},
+		"data_source_ref": {
+			Type:        schema.TypeList,
+			Description: "The object to populate the volume from, which can be any object of a group other than the core API group for which a volume populator is installed. When set for a VolumeSnapshot or a PersistentVolumeClaim, `data_source` is set to the same object by the API server.",
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: persistentVolumeClaimDataSourceFields(true),
+			},
+		},
 		"resources": {
 			Type:        schema.TypeList,
 			Description: "A list of the minimum resources the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources",
@@ -103,3 +125,82 @@ func persistentVolumeClaimSpecFields() map[string]*schema.Schema {
 		},
 	}
 }
+
+func persistentVolumeClaimDataSourceFields(isRef bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"api_group": {
+			Type:        schema.TypeString,
+			Description: "The group of the object, such as `snapshot.storage.k8s.io`. Leave empty for the core API group.",
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Description: "The kind of the object, such as `VolumeSnapshot` or `PersistentVolumeClaim`.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the object.",
+			Required:    true,
+			ForceNew:    true,
+		},
+	}
+	if isRef {
+		s["namespace"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The namespace of the object. Defaults to the namespace of the claim. Another namespace requires the `CrossNamespaceVolumeDataSource` feature gate and a ReferenceGrant in that namespace.",
+			Optional:    true,
+			ForceNew:    true,
+		}
+	}
+	return s
+}
+
+// The volume attributes class fields are not part of the version of k8s.io/api the provider is built with,
+// so they are only supported by the stand-alone PVC resource, which reads and writes them as unstructured.
+
+func persistentVolumeClaimVolumeAttributesClassNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the VolumeAttributesClass of the volume, which sets mutable attributes such as IOPS and throughput. Changing it modifies the volume in place. Once set, it cannot be removed without recreating the claim.",
+		Optional:    true,
+	}
+}
+
+func persistentVolumeClaimStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The current state of the claim's volume attributes class.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"current_volume_attributes_class_name": {
+					Type:        schema.TypeString,
+					Description: "The name of the VolumeAttributesClass currently applied to the volume.",
+					Computed:    true,
+				},
+				"modify_volume_status": {
+					Type:        schema.TypeList,
+					Description: "The status of the ongoing modification of the volume to another VolumeAttributesClass, if any.",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"status": {
+								Type:        schema.TypeString,
+								Description: "The status of the modification: `Pending`, `InProgress` or `Infeasible`.",
+								Computed:    true,
+							},
+							"target_volume_attributes_class_name": {
+								Type:        schema.TypeString,
+								Description: "The name of the VolumeAttributesClass the volume is being modified to.",
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
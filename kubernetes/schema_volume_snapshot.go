@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func volumeSnapshotWaitForReadyToUseSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: description,
+	}
+}
+
+func volumeSnapshotDeletionPolicySchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  description,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice([]string{"Delete", "Retain"}, false),
+	}
+}
+
+func volumeSnapshotErrorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The last error encountered while creating the snapshot, if any. Errors may be transient, in which case the snapshot controller keeps retrying.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"message": {
+					Type:        schema.TypeString,
+					Description: "A message describing the error.",
+					Computed:    true,
+				},
+				"time": {
+					Type:        schema.TypeString,
+					Description: "The time the error was encountered.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
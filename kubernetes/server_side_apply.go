@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/csaupgrade"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	applyModeClientSide = "client_side"
+	applyModeServerSide = "server_side"
+)
+
+// useServerSideApply returns whether the provider is configured to create and update
+// typed resources through server-side apply
+func useServerSideApply(meta interface{}) bool {
+	m, ok := meta.(providerMetadata)
+	return ok && m.ApplyMode == applyModeServerSide
+}
+
+// serverSideApplyBody returns the apply configuration of a typed object, with its kind set
+// from the client-go scheme and the fields the server owns (status, creationTimestamp) removed
+func serverSideApplyBody(obj runtime.Object) (*unstructured.Unstructured, error) {
+	gvks, _, err := scheme.Scheme.ObjectKinds(obj)
+	if err != nil {
+		return nil, err
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvks[0])
+	unstructured.RemoveNestedField(u.Object, "status")
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+	return u, nil
+}
+
+// clientSideFieldManagerName is the field manager the API server records for the create and
+// patch requests of the typed resources, derived from the user agent of the provider
+const clientSideFieldManagerName = "HashiCorp"
+
+// serverSideApplyCreate creates a typed object through server-side apply. An apply creates the
+// object or updates an existing one alike, so the object is looked up first, and an AlreadyExists
+// error is returned instead of silently taking over an object not created by Terraform.
+// Objects named by "generate_name" cannot be applied, so they are created with a create request,
+// and their fields are moved over to server-side apply by their first update.
+func serverSideApplyCreate(ctx context.Context, meta interface{}, resource string, obj runtime.Object, out runtime.Object) error {
+	rs, u, err := serverSideApplyResource(meta, resource, obj)
+	if err != nil {
+		return err
+	}
+	if u.GetName() == "" {
+		log.Printf("[INFO] Creating %s with generated name %q", u.GroupVersionKind(), u.GetGenerateName())
+		res, err := rs.Create(ctx, u, metav1.CreateOptions{FieldManager: defaultFieldManagerName})
+		if err != nil {
+			return err
+		}
+		return runtime.DefaultUnstructuredConverter.FromUnstructured(res.Object, out)
+	}
+	_, err = rs.Get(ctx, u.GetName(), metav1.GetOptions{})
+	if err == nil {
+		gvr := u.GroupVersionKind().GroupVersion().WithResource(resource)
+		return errors.NewAlreadyExists(gvr.GroupResource(), u.GetName())
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+	return serverSideApply(ctx, meta, rs, u, out)
+}
+
+// serverSideApplyUpdate updates a typed object through server-side apply. The fields set while
+// "apply_mode" was "client_side" are first moved over to the "Terraform" field manager, otherwise
+// the API server would keep them owned by the client-side field manager and never remove them
+// once they are dropped from the configuration.
+func serverSideApplyUpdate(ctx context.Context, meta interface{}, resource string, obj runtime.Object, out runtime.Object) error {
+	rs, u, err := serverSideApplyResource(meta, resource, obj)
+	if err != nil {
+		return err
+	}
+	err = upgradeClientSideManagedFields(ctx, rs, u.GetName())
+	if err != nil {
+		return fmt.Errorf("Failed to migrate the client-side managed fields of %q: %w", u.GetName(), err)
+	}
+	return serverSideApply(ctx, meta, rs, u, out)
+}
+
+// upgradeClientSideManagedFields transfers the fields owned by the client-side 'Update' operations of
+// the provider to the "Terraform" field manager. It is a no-op once they have been transferred.
+func upgradeClientSideManagedFields(ctx context.Context, rs dynamic.ResourceInterface, name string) error {
+	live, err := rs.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(live, sets.New(clientSideFieldManagerName, defaultFieldManagerName), defaultFieldManagerName)
+	if err != nil || patch == nil {
+		return err
+	}
+	log.Printf("[DEBUG] Migrating client-side managed fields of %q: %s", name, string(patch))
+	_, err = rs.Patch(ctx, name, types.JSONPatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// serverSideApplyResource returns the dynamic client of the resource of a typed object, along with its
+// apply configuration. resource is the plural name of the kind, e.g. "deployments".
+func serverSideApplyResource(meta interface{}, resource string, obj runtime.Object) (dynamic.ResourceInterface, *unstructured.Unstructured, error) {
+	m, ok := meta.(providerMetadata)
+	if !ok {
+		return nil, nil, fmt.Errorf("server-side apply is not supported by %T", meta)
+	}
+	client, err := m.DynamicClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	u, err := serverSideApplyBody(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	gvr := u.GroupVersionKind().GroupVersion().WithResource(resource)
+	var rs dynamic.ResourceInterface = client.Resource(gvr)
+	if u.GetNamespace() != "" {
+		rs = client.Resource(gvr).Namespace(u.GetNamespace())
+	}
+	return rs, u, nil
+}
+
+// serverSideApply applies an object with the "Terraform" field manager, so that only the
+// fields set in the configuration are owned by Terraform, and decodes the result into out.
+func serverSideApply(ctx context.Context, meta interface{}, rs dynamic.ResourceInterface, u *unstructured.Unstructured, out runtime.Object) error {
+	body, err := json.Marshal(u.Object)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Applying %s %q: %s", u.GroupVersionKind(), u.GetName(), string(body))
+	res, err := rs.Patch(ctx, u.GetName(), types.ApplyPatchType, body, metav1.PatchOptions{
+		FieldManager: defaultFieldManagerName,
+		Force:        ptr.To(meta.(providerMetadata).ForceConflicts),
+	})
+	if err != nil {
+		if errors.IsConflict(err) {
+			return fmt.Errorf(`Another client is managing a field Terraform tried to update. Set "force_conflicts" to true in the provider configuration to override: %w`, err)
+		}
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(res.Object, out)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestServerSideApplyBody(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(2)),
+		},
+	}
+	u, err := serverSideApplyBody(deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.GetAPIVersion() != "apps/v1" || u.GetKind() != "Deployment" {
+		t.Errorf("unexpected kind: %s %s", u.GetAPIVersion(), u.GetKind())
+	}
+	if _, ok := u.Object["status"]; ok {
+		t.Error("expected status to be removed")
+	}
+	if _, ok, _ := unstructured.NestedFieldNoCopy(u.Object, "metadata", "creationTimestamp"); ok {
+		t.Error("expected creationTimestamp to be removed")
+	}
+	if r, _, _ := unstructured.NestedInt64(u.Object, "spec", "replicas"); r != 2 {
+		t.Errorf("expected 2 replicas, got %d", r)
+	}
+}
+
+func TestUseServerSideApply(t *testing.T) {
+	cases := map[string]struct {
+		meta     interface{}
+		expected bool
+	}{
+		"default":     {meta: providerMetadata{}, expected: false},
+		"client_side": {meta: providerMetadata{ApplyMode: applyModeClientSide}, expected: false},
+		"server_side": {meta: providerMetadata{ApplyMode: applyModeServerSide}, expected: true},
+		"other":       {meta: nil, expected: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if useServerSideApply(tc.meta) != tc.expected {
+				t.Errorf("expected %t", tc.expected)
+			}
+		})
+	}
+}
+
+func TestServerSideApplyCreateExists(t *testing.T) {
+	existing := &unstructured.Unstructured{}
+	existing.SetAPIVersion("v1")
+	existing.SetKind("ConfigMap")
+	existing.SetName("test")
+	existing.SetNamespace("default")
+	meta := providerMetadata{
+		dynamicClient: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), existing),
+		ApplyMode:     applyModeServerSide,
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+	}
+	err := serverSideApplyCreate(context.Background(), meta, "configmaps", cm, &corev1.ConfigMap{})
+	if !errors.IsAlreadyExists(err) {
+		t.Errorf("expected an AlreadyExists error, got %v", err)
+	}
+}
+
+func TestUpgradeClientSideManagedFields(t *testing.T) {
+	fieldsV1 := func(f string) *metav1.FieldsV1 {
+		return &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:` + f + `":{}}}`)}
+	}
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: clientSideFieldManagerName, Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "v1", FieldsType: "FieldsV1", FieldsV1: fieldsV1("foo")},
+				{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "v1", FieldsType: "FieldsV1", FieldsV1: fieldsV1("bar")},
+			},
+		},
+		Data: map[string]string{"foo": "1", "bar": "2"},
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), &unstructured.Unstructured{Object: content})
+	rs := client.Resource(corev1.SchemeGroupVersion.WithResource("configmaps")).Namespace("default")
+
+	for i := 0; i < 2; i++ {
+		err = upgradeClientSideManagedFields(context.Background(), rs, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		live, err := rs.Get(context.Background(), "test", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		managers := map[string]metav1.ManagedFieldsOperationType{}
+		for _, e := range live.GetManagedFields() {
+			managers[e.Manager] = e.Operation
+		}
+		expected := map[string]metav1.ManagedFieldsOperationType{
+			defaultFieldManagerName: metav1.ManagedFieldsOperationApply,
+			"kubectl":               metav1.ManagedFieldsOperationUpdate,
+		}
+		if !reflect.DeepEqual(managers, expected) {
+			t.Errorf("unexpected managers after %d upgrades: %v", i+1, managers)
+		}
+	}
+}
+
+func TestServerSideApplyCreateGenerateName(t *testing.T) {
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	meta := providerMetadata{
+		dynamicClient: client,
+		ApplyMode:     applyModeServerSide,
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "test-",
+			Namespace:    "default",
+		},
+		Data: map[string]string{"foo": "bar"},
+	}
+	out := &corev1.ConfigMap{}
+	err := serverSideApplyCreate(context.Background(), meta, "configmaps", cm, out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.GenerateName != "test-" || out.Data["foo"] != "bar" {
+		t.Errorf("unexpected config map: %#v", out)
+	}
+	for _, a := range client.Actions() {
+		if a.GetVerb() != "create" {
+			t.Errorf("expected only a create request, got %s", a.GetVerb())
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+// Custom resources are unstructured, so their expanders build the JSON representation
+// of the object and their flatteners read it back with the helpers below.
+
+func putUnstructuredString(out map[string]interface{}, key string, v interface{}) {
+	if s, ok := v.(string); ok && s != "" {
+		out[key] = s
+	}
+}
+
+func putUnstructuredInt(out map[string]interface{}, key string, v interface{}) {
+	if i, ok := v.(int); ok && i != 0 {
+		out[key] = int64(i)
+	}
+}
+
+func unstructuredInt(v interface{}) int {
+	switch i := v.(type) {
+	case int64:
+		return int(i)
+	case float64:
+		return int(i)
+	case int:
+		return i
+	}
+	return 0
+}
+
+func unstructuredString(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+func unstructuredMaps(v interface{}) []map[string]interface{} {
+	l, _ := v.([]interface{})
+	out := make([]map[string]interface{}, 0, len(l))
+	for _, i := range l {
+		if m, ok := i.(map[string]interface{}); ok {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+func unstructuredMap(v interface{}) map[string]interface{} {
+	m, _ := v.(map[string]interface{})
+	return m
+}
+
+func firstSchemaBlock(v interface{}) (map[string]interface{}, bool) {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil, false
+	}
+	m, ok := l[0].(map[string]interface{})
+	return m, ok
+}
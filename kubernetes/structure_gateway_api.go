@@ -0,0 +1,774 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Gateway API objects are unstructured, so the expanders below build the JSON
+// representation of the spec and the flatteners read it back.
+
+func expandGatewayAPILabelSelector(l []interface{}) map[string]interface{} {
+	out, err := runtime.DefaultUnstructuredConverter.ToUnstructured(expandLabelSelector(l))
+	if err != nil {
+		return map[string]interface{}{}
+	}
+	return out
+}
+
+func flattenGatewayAPILabelSelector(in map[string]interface{}) []interface{} {
+	selector := &metav1.LabelSelector{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(in, selector); err != nil {
+		return []interface{}{}
+	}
+	return flattenLabelSelector(selector)
+}
+
+func flattenGatewayAPIConditions(in interface{}) []interface{} {
+	conditions := unstructuredMaps(in)
+	out := make([]interface{}, len(conditions))
+	for i, c := range conditions {
+		out[i] = map[string]interface{}{
+			"message": unstructuredString(c["message"]),
+			"reason":  unstructuredString(c["reason"]),
+			"status":  unstructuredString(c["status"]),
+			"type":    unstructuredString(c["type"]),
+		}
+	}
+	return out
+}
+
+// References
+
+func expandGatewayAPIParentReferences(l []interface{}) []interface{} {
+	out := make([]interface{}, 0, len(l))
+	for _, v := range l {
+		in, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ref := map[string]interface{}{"name": in["name"]}
+		putUnstructuredString(ref, "group", in["group"])
+		putUnstructuredString(ref, "kind", in["kind"])
+		putUnstructuredString(ref, "namespace", in["namespace"])
+		putUnstructuredString(ref, "sectionName", in["section_name"])
+		putUnstructuredInt(ref, "port", in["port"])
+		out = append(out, ref)
+	}
+	return out
+}
+
+func flattenGatewayAPIParentReferences(in interface{}) []interface{} {
+	refs := unstructuredMaps(in)
+	out := make([]interface{}, len(refs))
+	for i, ref := range refs {
+		out[i] = map[string]interface{}{
+			"group":        unstructuredString(ref["group"]),
+			"kind":         unstructuredString(ref["kind"]),
+			"name":         unstructuredString(ref["name"]),
+			"namespace":    unstructuredString(ref["namespace"]),
+			"port":         unstructuredInt(ref["port"]),
+			"section_name": unstructuredString(ref["sectionName"]),
+		}
+	}
+	return out
+}
+
+func expandGatewayAPIBackendReference(in map[string]interface{}) map[string]interface{} {
+	ref := map[string]interface{}{"name": in["name"]}
+	putUnstructuredString(ref, "group", in["group"])
+	putUnstructuredString(ref, "kind", in["kind"])
+	putUnstructuredString(ref, "namespace", in["namespace"])
+	putUnstructuredInt(ref, "port", in["port"])
+	if w, ok := in["weight"].(int); ok {
+		ref["weight"] = int64(w)
+	}
+	return ref
+}
+
+func expandGatewayAPIBackendReferences(l []interface{}) []interface{} {
+	out := make([]interface{}, 0, len(l))
+	for _, v := range l {
+		if in, ok := v.(map[string]interface{}); ok {
+			out = append(out, expandGatewayAPIBackendReference(in))
+		}
+	}
+	return out
+}
+
+func flattenGatewayAPIBackendReference(ref map[string]interface{}, weighted bool) map[string]interface{} {
+	out := map[string]interface{}{
+		"group":     unstructuredString(ref["group"]),
+		"kind":      unstructuredString(ref["kind"]),
+		"name":      unstructuredString(ref["name"]),
+		"namespace": unstructuredString(ref["namespace"]),
+		"port":      unstructuredInt(ref["port"]),
+	}
+	if weighted {
+		out["weight"] = 1
+		if w, ok := ref["weight"]; ok {
+			out["weight"] = unstructuredInt(w)
+		}
+	}
+	return out
+}
+
+func flattenGatewayAPIBackendReferences(in interface{}) []interface{} {
+	refs := unstructuredMaps(in)
+	out := make([]interface{}, len(refs))
+	for i, ref := range refs {
+		out[i] = flattenGatewayAPIBackendReference(ref, true)
+	}
+	return out
+}
+
+// Filters
+
+func expandGatewayAPIHeaders(l []interface{}) []interface{} {
+	out := make([]interface{}, 0, len(l))
+	for _, v := range l {
+		if in, ok := v.(map[string]interface{}); ok {
+			out = append(out, map[string]interface{}{
+				"name":  in["name"],
+				"value": in["value"],
+			})
+		}
+	}
+	return out
+}
+
+func flattenGatewayAPIHeaders(in interface{}) []interface{} {
+	headers := unstructuredMaps(in)
+	out := make([]interface{}, len(headers))
+	for i, h := range headers {
+		out[i] = map[string]interface{}{
+			"name":  unstructuredString(h["name"]),
+			"value": unstructuredString(h["value"]),
+		}
+	}
+	return out
+}
+
+func expandGatewayAPIHeaderModifier(in map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	if v, ok := in["add"].([]interface{}); ok && len(v) > 0 {
+		out["add"] = expandGatewayAPIHeaders(v)
+	}
+	if v, ok := in["set"].([]interface{}); ok && len(v) > 0 {
+		out["set"] = expandGatewayAPIHeaders(v)
+	}
+	if v, ok := in["remove"].([]interface{}); ok && len(v) > 0 {
+		remove := make([]interface{}, len(v))
+		copy(remove, v)
+		out["remove"] = remove
+	}
+	return out
+}
+
+func flattenGatewayAPIHeaderModifier(in map[string]interface{}) []interface{} {
+	remove, _ := in["remove"].([]interface{})
+	return []interface{}{map[string]interface{}{
+		"add":    flattenGatewayAPIHeaders(in["add"]),
+		"remove": remove,
+		"set":    flattenGatewayAPIHeaders(in["set"]),
+	}}
+}
+
+func expandGatewayAPIPathModifier(in map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{"type": in["type"]}
+	putUnstructuredString(out, "replaceFullPath", in["replace_full_path"])
+	putUnstructuredString(out, "replacePrefixMatch", in["replace_prefix_match"])
+	return out
+}
+
+func flattenGatewayAPIPathModifier(in map[string]interface{}) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"replace_full_path":    unstructuredString(in["replaceFullPath"]),
+		"replace_prefix_match": unstructuredString(in["replacePrefixMatch"]),
+		"type":                 unstructuredString(in["type"]),
+	}}
+}
+
+// expandGatewayAPIFilters expands the filters shared by HTTPRoute and GRPCRoute, as well as
+// the HTTPRoute only filters which are absent from the GRPCRoute schema.
+func expandGatewayAPIFilters(l []interface{}) []interface{} {
+	out := make([]interface{}, 0, len(l))
+	for _, v := range l {
+		in, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		filter := map[string]interface{}{"type": in["type"]}
+		if m, ok := firstSchemaBlock(in["request_header_modifier"]); ok {
+			filter["requestHeaderModifier"] = expandGatewayAPIHeaderModifier(m)
+		}
+		if m, ok := firstSchemaBlock(in["response_header_modifier"]); ok {
+			filter["responseHeaderModifier"] = expandGatewayAPIHeaderModifier(m)
+		}
+		if m, ok := firstSchemaBlock(in["request_mirror"]); ok {
+			mirror := map[string]interface{}{}
+			if ref, ok := firstSchemaBlock(m["backend_ref"]); ok {
+				mirror["backendRef"] = expandGatewayAPIBackendReference(ref)
+			}
+			filter["requestMirror"] = mirror
+		}
+		if m, ok := firstSchemaBlock(in["request_redirect"]); ok {
+			redirect := map[string]interface{}{}
+			putUnstructuredString(redirect, "scheme", m["scheme"])
+			putUnstructuredString(redirect, "hostname", m["hostname"])
+			putUnstructuredInt(redirect, "port", m["port"])
+			putUnstructuredInt(redirect, "statusCode", m["status_code"])
+			if p, ok := firstSchemaBlock(m["path"]); ok {
+				redirect["path"] = expandGatewayAPIPathModifier(p)
+			}
+			filter["requestRedirect"] = redirect
+		}
+		if m, ok := firstSchemaBlock(in["url_rewrite"]); ok {
+			rewrite := map[string]interface{}{}
+			putUnstructuredString(rewrite, "hostname", m["hostname"])
+			if p, ok := firstSchemaBlock(m["path"]); ok {
+				rewrite["path"] = expandGatewayAPIPathModifier(p)
+			}
+			filter["urlRewrite"] = rewrite
+		}
+		out = append(out, filter)
+	}
+	return out
+}
+
+func flattenGatewayAPIFilters(in interface{}, http bool) []interface{} {
+	filters := unstructuredMaps(in)
+	out := make([]interface{}, len(filters))
+	for i, f := range filters {
+		filter := map[string]interface{}{
+			"type": unstructuredString(f["type"]),
+		}
+		if m := unstructuredMap(f["requestHeaderModifier"]); m != nil {
+			filter["request_header_modifier"] = flattenGatewayAPIHeaderModifier(m)
+		}
+		if m := unstructuredMap(f["responseHeaderModifier"]); m != nil {
+			filter["response_header_modifier"] = flattenGatewayAPIHeaderModifier(m)
+		}
+		if m := unstructuredMap(f["requestMirror"]); m != nil {
+			mirror := map[string]interface{}{}
+			if ref := unstructuredMap(m["backendRef"]); ref != nil {
+				mirror["backend_ref"] = []interface{}{flattenGatewayAPIBackendReference(ref, false)}
+			}
+			filter["request_mirror"] = []interface{}{mirror}
+		}
+		if http {
+			if m := unstructuredMap(f["requestRedirect"]); m != nil {
+				redirect := map[string]interface{}{
+					"hostname":    unstructuredString(m["hostname"]),
+					"port":        unstructuredInt(m["port"]),
+					"scheme":      unstructuredString(m["scheme"]),
+					"status_code": unstructuredInt(m["statusCode"]),
+				}
+				if p := unstructuredMap(m["path"]); p != nil {
+					redirect["path"] = flattenGatewayAPIPathModifier(p)
+				}
+				filter["request_redirect"] = []interface{}{redirect}
+			}
+			if m := unstructuredMap(f["urlRewrite"]); m != nil {
+				rewrite := map[string]interface{}{
+					"hostname": unstructuredString(m["hostname"]),
+				}
+				if p := unstructuredMap(m["path"]); p != nil {
+					rewrite["path"] = flattenGatewayAPIPathModifier(p)
+				}
+				filter["url_rewrite"] = []interface{}{rewrite}
+			}
+		}
+		out[i] = filter
+	}
+	return out
+}
+
+func expandGatewayAPIHeaderMatches(l []interface{}) []interface{} {
+	out := make([]interface{}, 0, len(l))
+	for _, v := range l {
+		if in, ok := v.(map[string]interface{}); ok {
+			out = append(out, map[string]interface{}{
+				"type":  in["type"],
+				"name":  in["name"],
+				"value": in["value"],
+			})
+		}
+	}
+	return out
+}
+
+func flattenGatewayAPIHeaderMatches(in interface{}) []interface{} {
+	matches := unstructuredMaps(in)
+	out := make([]interface{}, len(matches))
+	for i, m := range matches {
+		out[i] = map[string]interface{}{
+			"name":  unstructuredString(m["name"]),
+			"type":  unstructuredString(m["type"]),
+			"value": unstructuredString(m["value"]),
+		}
+	}
+	return out
+}
+
+// Routes
+
+func expandGatewayAPIRouteSpec(in map[string]interface{}) map[string]interface{} {
+	spec := map[string]interface{}{}
+	if v, ok := in["parent_ref"].([]interface{}); ok && len(v) > 0 {
+		spec["parentRefs"] = expandGatewayAPIParentReferences(v)
+	}
+	if v, ok := in["hostnames"].([]interface{}); ok && len(v) > 0 {
+		hostnames := make([]interface{}, len(v))
+		copy(hostnames, v)
+		spec["hostnames"] = hostnames
+	}
+	return spec
+}
+
+func flattenGatewayAPIRouteSpec(in map[string]interface{}) map[string]interface{} {
+	hostnames, _ := in["hostnames"].([]interface{})
+	return map[string]interface{}{
+		"hostnames":  hostnames,
+		"parent_ref": flattenGatewayAPIParentReferences(in["parentRefs"]),
+	}
+}
+
+func flattenGatewayAPIRouteStatus(in map[string]interface{}) []interface{} {
+	parents := unstructuredMaps(in["parents"])
+	out := make([]interface{}, len(parents))
+	for i, p := range parents {
+		ref := unstructuredMap(p["parentRef"])
+		out[i] = map[string]interface{}{
+			"condition":       flattenGatewayAPIConditions(p["conditions"]),
+			"controller_name": unstructuredString(p["controllerName"]),
+			"name":            unstructuredString(ref["name"]),
+			"namespace":       unstructuredString(ref["namespace"]),
+			"section_name":    unstructuredString(ref["sectionName"]),
+		}
+	}
+	return []interface{}{map[string]interface{}{
+		"parent": out,
+	}}
+}
+
+func expandHTTPRouteV1Spec(l []interface{}) map[string]interface{} {
+	in, ok := firstSchemaBlock(l)
+	if !ok {
+		return map[string]interface{}{}
+	}
+	spec := expandGatewayAPIRouteSpec(in)
+	rules := make([]interface{}, 0)
+	for _, r := range unstructuredMaps(in["rule"]) {
+		rule := map[string]interface{}{}
+		if v, ok := r["match"].([]interface{}); ok && len(v) > 0 {
+			matches := make([]interface{}, 0, len(v))
+			for _, m := range unstructuredMaps(v) {
+				match := map[string]interface{}{}
+				if p, ok := firstSchemaBlock(m["path"]); ok {
+					match["path"] = map[string]interface{}{
+						"type":  p["type"],
+						"value": p["value"],
+					}
+				}
+				if h, ok := m["header"].([]interface{}); ok && len(h) > 0 {
+					match["headers"] = expandGatewayAPIHeaderMatches(h)
+				}
+				if q, ok := m["query_param"].([]interface{}); ok && len(q) > 0 {
+					match["queryParams"] = expandGatewayAPIHeaderMatches(q)
+				}
+				putUnstructuredString(match, "method", m["method"])
+				matches = append(matches, match)
+			}
+			rule["matches"] = matches
+		}
+		if v, ok := r["filter"].([]interface{}); ok && len(v) > 0 {
+			rule["filters"] = expandGatewayAPIFilters(v)
+		}
+		if v, ok := r["backend_ref"].([]interface{}); ok && len(v) > 0 {
+			rule["backendRefs"] = expandGatewayAPIBackendReferences(v)
+		}
+		if t, ok := firstSchemaBlock(r["timeouts"]); ok {
+			timeouts := map[string]interface{}{}
+			putUnstructuredString(timeouts, "request", t["request"])
+			putUnstructuredString(timeouts, "backendRequest", t["backend_request"])
+			rule["timeouts"] = timeouts
+		}
+		rules = append(rules, rule)
+	}
+	if len(rules) > 0 {
+		spec["rules"] = rules
+	}
+	return spec
+}
+
+func flattenHTTPRouteV1Spec(in map[string]interface{}) []interface{} {
+	spec := flattenGatewayAPIRouteSpec(in)
+	rules := unstructuredMaps(in["rules"])
+	flattened := make([]interface{}, len(rules))
+	for i, r := range rules {
+		matches := unstructuredMaps(r["matches"])
+		flattenedMatches := make([]interface{}, len(matches))
+		for j, m := range matches {
+			match := map[string]interface{}{
+				"header":      flattenGatewayAPIHeaderMatches(m["headers"]),
+				"method":      unstructuredString(m["method"]),
+				"query_param": flattenGatewayAPIHeaderMatches(m["queryParams"]),
+			}
+			if p := unstructuredMap(m["path"]); p != nil {
+				match["path"] = []interface{}{map[string]interface{}{
+					"type":  unstructuredString(p["type"]),
+					"value": unstructuredString(p["value"]),
+				}}
+			}
+			flattenedMatches[j] = match
+		}
+		rule := map[string]interface{}{
+			"backend_ref": flattenGatewayAPIBackendReferences(r["backendRefs"]),
+			"filter":      flattenGatewayAPIFilters(r["filters"], true),
+			"match":       flattenedMatches,
+		}
+		if t := unstructuredMap(r["timeouts"]); t != nil {
+			rule["timeouts"] = []interface{}{map[string]interface{}{
+				"backend_request": unstructuredString(t["backendRequest"]),
+				"request":         unstructuredString(t["request"]),
+			}}
+		}
+		flattened[i] = rule
+	}
+	spec["rule"] = flattened
+	return []interface{}{spec}
+}
+
+func expandGRPCRouteV1Spec(l []interface{}) map[string]interface{} {
+	in, ok := firstSchemaBlock(l)
+	if !ok {
+		return map[string]interface{}{}
+	}
+	spec := expandGatewayAPIRouteSpec(in)
+	rules := make([]interface{}, 0)
+	for _, r := range unstructuredMaps(in["rule"]) {
+		rule := map[string]interface{}{}
+		if v, ok := r["match"].([]interface{}); ok && len(v) > 0 {
+			matches := make([]interface{}, 0, len(v))
+			for _, m := range unstructuredMaps(v) {
+				match := map[string]interface{}{}
+				if p, ok := firstSchemaBlock(m["method"]); ok {
+					method := map[string]interface{}{"type": p["type"]}
+					putUnstructuredString(method, "service", p["service"])
+					putUnstructuredString(method, "method", p["method"])
+					match["method"] = method
+				}
+				if h, ok := m["header"].([]interface{}); ok && len(h) > 0 {
+					match["headers"] = expandGatewayAPIHeaderMatches(h)
+				}
+				matches = append(matches, match)
+			}
+			rule["matches"] = matches
+		}
+		if v, ok := r["filter"].([]interface{}); ok && len(v) > 0 {
+			rule["filters"] = expandGatewayAPIFilters(v)
+		}
+		if v, ok := r["backend_ref"].([]interface{}); ok && len(v) > 0 {
+			rule["backendRefs"] = expandGatewayAPIBackendReferences(v)
+		}
+		rules = append(rules, rule)
+	}
+	if len(rules) > 0 {
+		spec["rules"] = rules
+	}
+	return spec
+}
+
+func flattenGRPCRouteV1Spec(in map[string]interface{}) []interface{} {
+	spec := flattenGatewayAPIRouteSpec(in)
+	rules := unstructuredMaps(in["rules"])
+	flattened := make([]interface{}, len(rules))
+	for i, r := range rules {
+		matches := unstructuredMaps(r["matches"])
+		flattenedMatches := make([]interface{}, len(matches))
+		for j, m := range matches {
+			match := map[string]interface{}{
+				"header": flattenGatewayAPIHeaderMatches(m["headers"]),
+			}
+			if p := unstructuredMap(m["method"]); p != nil {
+				match["method"] = []interface{}{map[string]interface{}{
+					"method":  unstructuredString(p["method"]),
+					"service": unstructuredString(p["service"]),
+					"type":    unstructuredString(p["type"]),
+				}}
+			}
+			flattenedMatches[j] = match
+		}
+		flattened[i] = map[string]interface{}{
+			"backend_ref": flattenGatewayAPIBackendReferences(r["backendRefs"]),
+			"filter":      flattenGatewayAPIFilters(r["filters"], false),
+			"match":       flattenedMatches,
+		}
+	}
+	spec["rule"] = flattened
+	return []interface{}{spec}
+}
+
+// Gateways
+
+func expandGatewayClassV1Spec(l []interface{}) map[string]interface{} {
+	in, ok := firstSchemaBlock(l)
+	if !ok {
+		return map[string]interface{}{}
+	}
+	spec := map[string]interface{}{"controllerName": in["controller_name"]}
+	putUnstructuredString(spec, "description", in["description"])
+	if p, ok := firstSchemaBlock(in["parameters_ref"]); ok {
+		ref := map[string]interface{}{
+			"group": p["group"],
+			"kind":  p["kind"],
+			"name":  p["name"],
+		}
+		putUnstructuredString(ref, "namespace", p["namespace"])
+		spec["parametersRef"] = ref
+	}
+	return spec
+}
+
+func flattenGatewayClassV1Spec(in map[string]interface{}) []interface{} {
+	spec := map[string]interface{}{
+		"controller_name": unstructuredString(in["controllerName"]),
+		"description":     unstructuredString(in["description"]),
+	}
+	if p := unstructuredMap(in["parametersRef"]); p != nil {
+		spec["parameters_ref"] = []interface{}{map[string]interface{}{
+			"group":     unstructuredString(p["group"]),
+			"kind":      unstructuredString(p["kind"]),
+			"name":      unstructuredString(p["name"]),
+			"namespace": unstructuredString(p["namespace"]),
+		}}
+	}
+	return []interface{}{spec}
+}
+
+func expandGatewayV1Spec(l []interface{}) map[string]interface{} {
+	in, ok := firstSchemaBlock(l)
+	if !ok {
+		return map[string]interface{}{}
+	}
+	spec := map[string]interface{}{"gatewayClassName": in["gateway_class_name"]}
+
+	listeners := make([]interface{}, 0)
+	for _, l := range unstructuredMaps(in["listener"]) {
+		listener := map[string]interface{}{
+			"name":     l["name"],
+			"port":     int64(l["port"].(int)),
+			"protocol": l["protocol"],
+		}
+		putUnstructuredString(listener, "hostname", l["hostname"])
+		if t, ok := firstSchemaBlock(l["tls"]); ok {
+			tls := map[string]interface{}{}
+			putUnstructuredString(tls, "mode", t["mode"])
+			refs := make([]interface{}, 0)
+			for _, r := range unstructuredMaps(t["certificate_ref"]) {
+				ref := map[string]interface{}{"name": r["name"]}
+				putUnstructuredString(ref, "group", r["group"])
+				putUnstructuredString(ref, "kind", r["kind"])
+				putUnstructuredString(ref, "namespace", r["namespace"])
+				refs = append(refs, ref)
+			}
+			if len(refs) > 0 {
+				tls["certificateRefs"] = refs
+			}
+			if o, ok := t["options"].(map[string]interface{}); ok && len(o) > 0 {
+				options := make(map[string]interface{}, len(o))
+				for k, v := range o {
+					options[k] = v
+				}
+				tls["options"] = options
+			}
+			listener["tls"] = tls
+		}
+		if a, ok := firstSchemaBlock(l["allowed_routes"]); ok {
+			allowed := map[string]interface{}{}
+			if n, ok := firstSchemaBlock(a["namespaces"]); ok {
+				namespaces := map[string]interface{}{}
+				putUnstructuredString(namespaces, "from", n["from"])
+				if s, ok := n["selector"].([]interface{}); ok && len(s) > 0 {
+					namespaces["selector"] = expandGatewayAPILabelSelector(s)
+				}
+				allowed["namespaces"] = namespaces
+			}
+			kinds := make([]interface{}, 0)
+			for _, k := range unstructuredMaps(a["kind"]) {
+				kind := map[string]interface{}{"kind": k["kind"]}
+				putUnstructuredString(kind, "group", k["group"])
+				kinds = append(kinds, kind)
+			}
+			if len(kinds) > 0 {
+				allowed["kinds"] = kinds
+			}
+			listener["allowedRoutes"] = allowed
+		}
+		listeners = append(listeners, listener)
+	}
+	spec["listeners"] = listeners
+
+	addresses := make([]interface{}, 0)
+	for _, a := range unstructuredMaps(in["address"]) {
+		address := map[string]interface{}{"value": a["value"]}
+		putUnstructuredString(address, "type", a["type"])
+		addresses = append(addresses, address)
+	}
+	if len(addresses) > 0 {
+		spec["addresses"] = addresses
+	}
+	return spec
+}
+
+func flattenGatewayV1Spec(in map[string]interface{}) []interface{} {
+	listeners := unstructuredMaps(in["listeners"])
+	flattenedListeners := make([]interface{}, len(listeners))
+	for i, l := range listeners {
+		listener := map[string]interface{}{
+			"hostname": unstructuredString(l["hostname"]),
+			"name":     unstructuredString(l["name"]),
+			"port":     unstructuredInt(l["port"]),
+			"protocol": unstructuredString(l["protocol"]),
+		}
+		if t := unstructuredMap(l["tls"]); t != nil {
+			refs := unstructuredMaps(t["certificateRefs"])
+			flattenedRefs := make([]interface{}, len(refs))
+			for j, r := range refs {
+				flattenedRefs[j] = map[string]interface{}{
+					"group":     unstructuredString(r["group"]),
+					"kind":      unstructuredString(r["kind"]),
+					"name":      unstructuredString(r["name"]),
+					"namespace": unstructuredString(r["namespace"]),
+				}
+			}
+			listener["tls"] = []interface{}{map[string]interface{}{
+				"certificate_ref": flattenedRefs,
+				"mode":            unstructuredString(t["mode"]),
+				"options":         unstructuredMap(t["options"]),
+			}}
+		}
+		if a := unstructuredMap(l["allowedRoutes"]); a != nil {
+			allowed := map[string]interface{}{}
+			if n := unstructuredMap(a["namespaces"]); n != nil {
+				namespaces := map[string]interface{}{
+					"from": unstructuredString(n["from"]),
+				}
+				if s := unstructuredMap(n["selector"]); s != nil {
+					namespaces["selector"] = flattenGatewayAPILabelSelector(s)
+				}
+				allowed["namespaces"] = []interface{}{namespaces}
+			}
+			kinds := unstructuredMaps(a["kinds"])
+			flattenedKinds := make([]interface{}, len(kinds))
+			for j, k := range kinds {
+				flattenedKinds[j] = map[string]interface{}{
+					"group": unstructuredString(k["group"]),
+					"kind":  unstructuredString(k["kind"]),
+				}
+			}
+			allowed["kind"] = flattenedKinds
+			listener["allowed_routes"] = []interface{}{allowed}
+		}
+		flattenedListeners[i] = listener
+	}
+
+	addresses := unstructuredMaps(in["addresses"])
+	flattenedAddresses := make([]interface{}, len(addresses))
+	for i, a := range addresses {
+		flattenedAddresses[i] = map[string]interface{}{
+			"type":  unstructuredString(a["type"]),
+			"value": unstructuredString(a["value"]),
+		}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"address":            flattenedAddresses,
+		"gateway_class_name": unstructuredString(in["gatewayClassName"]),
+		"listener":           flattenedListeners,
+	}}
+}
+
+func flattenGatewayV1Status(in map[string]interface{}) []interface{} {
+	addresses := unstructuredMaps(in["addresses"])
+	flattenedAddresses := make([]interface{}, len(addresses))
+	for i, a := range addresses {
+		flattenedAddresses[i] = map[string]interface{}{
+			"type":  unstructuredString(a["type"]),
+			"value": unstructuredString(a["value"]),
+		}
+	}
+	listeners := unstructuredMaps(in["listeners"])
+	flattenedListeners := make([]interface{}, len(listeners))
+	for i, l := range listeners {
+		flattenedListeners[i] = map[string]interface{}{
+			"attached_routes": unstructuredInt(l["attachedRoutes"]),
+			"condition":       flattenGatewayAPIConditions(l["conditions"]),
+			"name":            unstructuredString(l["name"]),
+		}
+	}
+	return []interface{}{map[string]interface{}{
+		"address":   flattenedAddresses,
+		"condition": flattenGatewayAPIConditions(in["conditions"]),
+		"listener":  flattenedListeners,
+	}}
+}
+
+// Reference grants
+
+func expandReferenceGrantV1beta1Spec(l []interface{}) map[string]interface{} {
+	in, ok := firstSchemaBlock(l)
+	if !ok {
+		return map[string]interface{}{}
+	}
+	from := make([]interface{}, 0)
+	for _, f := range unstructuredMaps(in["from"]) {
+		from = append(from, map[string]interface{}{
+			"group":     f["group"],
+			"kind":      f["kind"],
+			"namespace": f["namespace"],
+		})
+	}
+	to := make([]interface{}, 0)
+	for _, t := range unstructuredMaps(in["to"]) {
+		ref := map[string]interface{}{
+			"group": t["group"],
+			"kind":  t["kind"],
+		}
+		putUnstructuredString(ref, "name", t["name"])
+		to = append(to, ref)
+	}
+	return map[string]interface{}{
+		"from": from,
+		"to":   to,
+	}
+}
+
+func flattenReferenceGrantV1beta1Spec(in map[string]interface{}) []interface{} {
+	from := unstructuredMaps(in["from"])
+	flattenedFrom := make([]interface{}, len(from))
+	for i, f := range from {
+		flattenedFrom[i] = map[string]interface{}{
+			"group":     unstructuredString(f["group"]),
+			"kind":      unstructuredString(f["kind"]),
+			"namespace": unstructuredString(f["namespace"]),
+		}
+	}
+	to := unstructuredMaps(in["to"])
+	flattenedTo := make([]interface{}, len(to))
+	for i, t := range to {
+		flattenedTo[i] = map[string]interface{}{
+			"group": unstructuredString(t["group"]),
+			"kind":  unstructuredString(t["kind"]),
+			"name":  unstructuredString(t["name"]),
+		}
+	}
+	return []interface{}{map[string]interface{}{
+		"from": flattenedFrom,
+		"to":   flattenedTo,
+	}}
+}
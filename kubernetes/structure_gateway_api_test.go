@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestExpandFlattenHTTPRouteV1Spec(t *testing.T) {
+	spec := map[string]interface{}{
+		"parentRefs": []interface{}{
+			map[string]interface{}{
+				"group":       "gateway.networking.k8s.io",
+				"kind":        "Gateway",
+				"name":        "example",
+				"namespace":   "infra",
+				"sectionName": "https",
+			},
+		},
+		"hostnames": []interface{}{"example.com"},
+		"rules": []interface{}{
+			map[string]interface{}{
+				"matches": []interface{}{
+					map[string]interface{}{
+						"path": map[string]interface{}{
+							"type":  "PathPrefix",
+							"value": "/api",
+						},
+						"headers": []interface{}{
+							map[string]interface{}{"type": "Exact", "name": "x-version", "value": "2"},
+						},
+						"method": "GET",
+					},
+				},
+				"filters": []interface{}{
+					map[string]interface{}{
+						"type": "RequestHeaderModifier",
+						"requestHeaderModifier": map[string]interface{}{
+							"set":    []interface{}{map[string]interface{}{"name": "x-env", "value": "prod"}},
+							"remove": []interface{}{"x-debug"},
+						},
+					},
+					map[string]interface{}{
+						"type": "URLRewrite",
+						"urlRewrite": map[string]interface{}{
+							"path": map[string]interface{}{
+								"type":               "ReplacePrefixMatch",
+								"replacePrefixMatch": "/",
+							},
+						},
+					},
+				},
+				"backendRefs": []interface{}{
+					map[string]interface{}{"kind": "Service", "name": "api-v1", "port": int64(8080), "weight": int64(90)},
+					map[string]interface{}{"kind": "Service", "name": "api-v2", "port": int64(8080), "weight": int64(0)},
+				},
+				"timeouts": map[string]interface{}{
+					"request": "30s",
+				},
+			},
+		},
+	}
+
+	d := resourceKubernetesHTTPRouteV1().TestResourceData()
+	if err := d.Set("spec", flattenHTTPRouteV1Spec(spec)); err != nil {
+		t.Fatal(err)
+	}
+	out := expandHTTPRouteV1Spec(d.Get("spec").([]interface{}))
+	if !reflect.DeepEqual(out, spec) {
+		t.Fatalf("Unexpected round trip.\nExpected: %#v\nGiven:    %#v", spec, out)
+	}
+}
+
+func TestExpandFlattenGRPCRouteV1Spec(t *testing.T) {
+	spec := map[string]interface{}{
+		"parentRefs": []interface{}{
+			map[string]interface{}{"group": "gateway.networking.k8s.io", "kind": "Gateway", "name": "example"},
+		},
+		"rules": []interface{}{
+			map[string]interface{}{
+				"matches": []interface{}{
+					map[string]interface{}{
+						"method": map[string]interface{}{
+							"type":    "Exact",
+							"service": "helloworld.Greeter",
+							"method":  "SayHello",
+						},
+					},
+				},
+				"backendRefs": []interface{}{
+					map[string]interface{}{"kind": "Service", "name": "greeter", "port": int64(50051), "weight": int64(1)},
+				},
+			},
+		},
+	}
+
+	d := resourceKubernetesGRPCRouteV1().TestResourceData()
+	if err := d.Set("spec", flattenGRPCRouteV1Spec(spec)); err != nil {
+		t.Fatal(err)
+	}
+	out := expandGRPCRouteV1Spec(d.Get("spec").([]interface{}))
+	if !reflect.DeepEqual(out, spec) {
+		t.Fatalf("Unexpected round trip.\nExpected: %#v\nGiven:    %#v", spec, out)
+	}
+}
+
+func TestExpandFlattenGatewayV1Spec(t *testing.T) {
+	spec := map[string]interface{}{
+		"gatewayClassName": "example",
+		"listeners": []interface{}{
+			map[string]interface{}{
+				"name":     "https",
+				"hostname": "*.example.com",
+				"port":     int64(443),
+				"protocol": "HTTPS",
+				"tls": map[string]interface{}{
+					"mode": "Terminate",
+					"certificateRefs": []interface{}{
+						map[string]interface{}{"kind": "Secret", "name": "example-tls"},
+					},
+				},
+				"allowedRoutes": map[string]interface{}{
+					"namespaces": map[string]interface{}{
+						"from": "Selector",
+						"selector": map[string]interface{}{
+							"matchLabels": map[string]interface{}{"gateway-access": "true"},
+						},
+					},
+					"kinds": []interface{}{
+						map[string]interface{}{"group": "gateway.networking.k8s.io", "kind": "HTTPRoute"},
+					},
+				},
+			},
+		},
+		"addresses": []interface{}{
+			map[string]interface{}{"type": "IPAddress", "value": "10.0.0.10"},
+		},
+	}
+
+	d := resourceKubernetesGatewayV1().TestResourceData()
+	if err := d.Set("spec", flattenGatewayV1Spec(spec)); err != nil {
+		t.Fatal(err)
+	}
+	out := expandGatewayV1Spec(d.Get("spec").([]interface{}))
+	if !reflect.DeepEqual(out, spec) {
+		t.Fatalf("Unexpected round trip.\nExpected: %#v\nGiven:    %#v", spec, out)
+	}
+}
+
+func TestExpandFlattenReferenceGrantV1beta1Spec(t *testing.T) {
+	spec := map[string]interface{}{
+		"from": []interface{}{
+			map[string]interface{}{"group": "gateway.networking.k8s.io", "kind": "HTTPRoute", "namespace": "apps"},
+		},
+		"to": []interface{}{
+			map[string]interface{}{"group": "", "kind": "Service"},
+		},
+	}
+
+	d := resourceKubernetesReferenceGrantV1beta1().TestResourceData()
+	if err := d.Set("spec", flattenReferenceGrantV1beta1Spec(spec)); err != nil {
+		t.Fatal(err)
+	}
+	out := expandReferenceGrantV1beta1Spec(d.Get("spec").([]interface{}))
+	if !reflect.DeepEqual(out, spec) {
+		t.Fatalf("Unexpected round trip.\nExpected: %#v\nGiven:    %#v", spec, out)
+	}
+}
+
+func TestRouteParentsAccepted(t *testing.T) {
+	route := func(generation int64, parents ...interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"status": map[string]interface{}{"parents": parents},
+		}}
+		obj.SetGeneration(generation)
+		return obj
+	}
+	parent := func(name string, conditions ...interface{}) interface{} {
+		return map[string]interface{}{
+			"parentRef":  map[string]interface{}{"name": name},
+			"conditions": conditions,
+		}
+	}
+	condition := func(conditionType, status string, observedGeneration int64) interface{} {
+		return map[string]interface{}{
+			"type":               conditionType,
+			"status":             status,
+			"reason":             "Reason",
+			"message":            "message",
+			"observedGeneration": observedGeneration,
+		}
+	}
+
+	cases := []struct {
+		name  string
+		route *unstructured.Unstructured
+		err   string
+	}{
+		{
+			name:  "no parents",
+			route: route(1),
+			err:   "no parent has reported a status",
+		},
+		{
+			name:  "accepted",
+			route: route(2, parent("a", condition("Accepted", "True", 2), condition("ResolvedRefs", "True", 2))),
+		},
+		{
+			name:  "refs not resolved",
+			route: route(1, parent("a", condition("Accepted", "True", 1), condition("ResolvedRefs", "False", 1))),
+			err:   "parent a: condition ResolvedRefs is False",
+		},
+		{
+			name:  "stale condition",
+			route: route(3, parent("a", condition("Accepted", "True", 2), condition("ResolvedRefs", "True", 2))),
+			err:   "parent a: condition Accepted was reported for an older generation",
+		},
+		{
+			name: "one parent not accepted",
+			route: route(1,
+				parent("a", condition("Accepted", "True", 1), condition("ResolvedRefs", "True", 1)),
+				parent("b", condition("ResolvedRefs", "True", 1)),
+			),
+			err: "parent b: condition Accepted is not reported",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := routeParentsAccepted(tc.route)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tc.err) {
+				t.Fatalf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
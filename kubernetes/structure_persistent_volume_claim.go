@@ -29,6 +29,37 @@ func flattenPersistentVolumeClaimSpec(in corev1.PersistentVolumeClaimSpec) []int
 	if in.VolumeMode != nil {
 		att["volume_mode"] = in.VolumeMode
 	}
+	if in.DataSource != nil {
+		att["data_source"] = flattenPersistentVolumeClaimDataSource(in.DataSource)
+	}
+	if in.DataSourceRef != nil {
+		att["data_source_ref"] = flattenPersistentVolumeClaimDataSourceRef(in.DataSourceRef)
+	}
+	return []interface{}{att}
+}
+
+func flattenPersistentVolumeClaimDataSource(in *corev1.TypedLocalObjectReference) []interface{} {
+	att := map[string]interface{}{
+		"kind": in.Kind,
+		"name": in.Name,
+	}
+	if in.APIGroup != nil {
+		att["api_group"] = *in.APIGroup
+	}
+	return []interface{}{att}
+}
+
+func flattenPersistentVolumeClaimDataSourceRef(in *corev1.TypedObjectReference) []interface{} {
+	att := map[string]interface{}{
+		"kind": in.Kind,
+		"name": in.Name,
+	}
+	if in.APIGroup != nil {
+		att["api_group"] = *in.APIGroup
+	}
+	if in.Namespace != nil {
+		att["namespace"] = *in.Namespace
+	}
 	return []interface{}{att}
 }
 
@@ -91,9 +122,48 @@ func expandPersistentVolumeClaimSpec(l []interface{}) (*corev1.PersistentVolumeC
 	if v, ok := in["volume_mode"].(string); ok && v != "" {
 		obj.VolumeMode = ptr.To(corev1.PersistentVolumeMode(v))
 	}
+	if v, ok := in["data_source"].([]interface{}); ok && len(v) > 0 {
+		obj.DataSource = expandPersistentVolumeClaimDataSource(v)
+	}
+	if v, ok := in["data_source_ref"].([]interface{}); ok && len(v) > 0 {
+		obj.DataSourceRef = expandPersistentVolumeClaimDataSourceRef(v)
+	}
 	return obj, nil
 }
 
+func expandPersistentVolumeClaimDataSource(l []interface{}) *corev1.TypedLocalObjectReference {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &corev1.TypedLocalObjectReference{
+		Kind: in["kind"].(string),
+		Name: in["name"].(string),
+	}
+	if v, ok := in["api_group"].(string); ok && v != "" {
+		obj.APIGroup = ptr.To(v)
+	}
+	return obj
+}
+
+func expandPersistentVolumeClaimDataSourceRef(l []interface{}) *corev1.TypedObjectReference {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &corev1.TypedObjectReference{
+		Kind: in["kind"].(string),
+		Name: in["name"].(string),
+	}
+	if v, ok := in["api_group"].(string); ok && v != "" {
+		obj.APIGroup = ptr.To(v)
+	}
+	if v, ok := in["namespace"].(string); ok && v != "" {
+		obj.Namespace = ptr.To(v)
+	}
+	return obj
+}
+
 func expandResourceRequirements(l []interface{}) (*corev1.ResourceRequirements, error) {
 	obj := &corev1.ResourceRequirements{}
 	if len(l) == 0 || l[0] == nil {
@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func flattenNamedRuleWithOperations(in admissionregistrationv1beta1.NamedRuleWithOperations) map[string]interface{} {
+	att := flattenRuleWithOperations(in.RuleWithOperations)
+	att["resource_names"] = in.ResourceNames
+	return att
+}
+
+func expandNamedRuleWithOperations(in map[string]interface{}) admissionregistrationv1beta1.NamedRuleWithOperations {
+	obj := admissionregistrationv1beta1.NamedRuleWithOperations{
+		RuleWithOperations: expandRuleWithOperations(in),
+	}
+
+	if v, ok := in["resource_names"].([]interface{}); ok {
+		obj.ResourceNames = expandStringSlice(v)
+	}
+
+	return obj
+}
+
+func flattenMatchResources(in *admissionregistrationv1beta1.MatchResources) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	att := map[string]interface{}{}
+
+	if in.MatchPolicy != nil {
+		att["match_policy"] = *in.MatchPolicy
+	}
+
+	if in.NamespaceSelector != nil {
+		if in.NamespaceSelector.MatchExpressions != nil || in.NamespaceSelector.MatchLabels != nil {
+			att["namespace_selector"] = flattenLabelSelector(in.NamespaceSelector)
+		}
+	}
+
+	if in.ObjectSelector != nil {
+		if in.ObjectSelector.MatchExpressions != nil || in.ObjectSelector.MatchLabels != nil {
+			att["object_selector"] = flattenLabelSelector(in.ObjectSelector)
+		}
+	}
+
+	rules := []interface{}{}
+	for _, rule := range in.ResourceRules {
+		rules = append(rules, flattenNamedRuleWithOperations(rule))
+	}
+	att["resource_rule"] = rules
+
+	excluded := []interface{}{}
+	for _, rule := range in.ExcludeResourceRules {
+		excluded = append(excluded, flattenNamedRuleWithOperations(rule))
+	}
+	att["exclude_resource_rule"] = excluded
+
+	return []interface{}{att}
+}
+
+func expandMatchResources(l []interface{}) *admissionregistrationv1beta1.MatchResources {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &admissionregistrationv1beta1.MatchResources{}
+
+	if v, ok := in["match_policy"].(string); ok && v != "" {
+		policy := admissionregistrationv1beta1.MatchPolicyType(v)
+		obj.MatchPolicy = &policy
+	}
+
+	if v, ok := in["namespace_selector"].([]interface{}); ok && len(v) != 0 {
+		obj.NamespaceSelector = expandLabelSelector(v)
+	}
+
+	if v, ok := in["object_selector"].([]interface{}); ok && len(v) != 0 {
+		obj.ObjectSelector = expandLabelSelector(v)
+	}
+
+	if v, ok := in["resource_rule"].([]interface{}); ok {
+		for _, r := range v {
+			obj.ResourceRules = append(obj.ResourceRules, expandNamedRuleWithOperations(r.(map[string]interface{})))
+		}
+	}
+
+	if v, ok := in["exclude_resource_rule"].([]interface{}); ok {
+		for _, r := range v {
+			obj.ExcludeResourceRules = append(obj.ExcludeResourceRules, expandNamedRuleWithOperations(r.(map[string]interface{})))
+		}
+	}
+
+	return obj
+}
+
+func flattenValidatingAdmissionPolicySpec(in admissionregistrationv1beta1.ValidatingAdmissionPolicySpec) []interface{} {
+	att := map[string]interface{}{}
+
+	if in.ParamKind != nil {
+		att["param_kind"] = []interface{}{map[string]interface{}{
+			"api_version": in.ParamKind.APIVersion,
+			"kind":        in.ParamKind.Kind,
+		}}
+	}
+
+	att["match_constraints"] = flattenMatchResources(in.MatchConstraints)
+
+	validations := []interface{}{}
+	for _, v := range in.Validations {
+		m := map[string]interface{}{
+			"expression":         v.Expression,
+			"message":            v.Message,
+			"message_expression": v.MessageExpression,
+		}
+		if v.Reason != nil {
+			m["reason"] = string(*v.Reason)
+		}
+		validations = append(validations, m)
+	}
+	att["validation"] = validations
+
+	if in.FailurePolicy != nil {
+		att["failure_policy"] = string(*in.FailurePolicy)
+	}
+
+	annotations := []interface{}{}
+	for _, a := range in.AuditAnnotations {
+		annotations = append(annotations, map[string]interface{}{
+			"key":              a.Key,
+			"value_expression": a.ValueExpression,
+		})
+	}
+	att["audit_annotation"] = annotations
+
+	conditions := []interface{}{}
+	for _, c := range in.MatchConditions {
+		conditions = append(conditions, map[string]interface{}{
+			"name":       c.Name,
+			"expression": c.Expression,
+		})
+	}
+	att["match_condition"] = conditions
+
+	variables := []interface{}{}
+	for _, v := range in.Variables {
+		variables = append(variables, map[string]interface{}{
+			"name":       v.Name,
+			"expression": v.Expression,
+		})
+	}
+	att["variable"] = variables
+
+	return []interface{}{att}
+}
+
+func expandValidatingAdmissionPolicySpec(l []interface{}) admissionregistrationv1beta1.ValidatingAdmissionPolicySpec {
+	obj := admissionregistrationv1beta1.ValidatingAdmissionPolicySpec{}
+
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["param_kind"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		pk := v[0].(map[string]interface{})
+		obj.ParamKind = &admissionregistrationv1beta1.ParamKind{
+			APIVersion: pk["api_version"].(string),
+			Kind:       pk["kind"].(string),
+		}
+	}
+
+	if v, ok := in["match_constraints"].([]interface{}); ok {
+		obj.MatchConstraints = expandMatchResources(v)
+	}
+
+	if v, ok := in["validation"].([]interface{}); ok {
+		for _, e := range v {
+			m := e.(map[string]interface{})
+			validation := admissionregistrationv1beta1.Validation{
+				Expression:        m["expression"].(string),
+				Message:           m["message"].(string),
+				MessageExpression: m["message_expression"].(string),
+			}
+			if r, ok := m["reason"].(string); ok && r != "" {
+				reason := metav1.StatusReason(r)
+				validation.Reason = &reason
+			}
+			obj.Validations = append(obj.Validations, validation)
+		}
+	}
+
+	if v, ok := in["failure_policy"].(string); ok && v != "" {
+		policy := admissionregistrationv1beta1.FailurePolicyType(v)
+		obj.FailurePolicy = &policy
+	}
+
+	if v, ok := in["audit_annotation"].([]interface{}); ok {
+		for _, e := range v {
+			m := e.(map[string]interface{})
+			obj.AuditAnnotations = append(obj.AuditAnnotations, admissionregistrationv1beta1.AuditAnnotation{
+				Key:             m["key"].(string),
+				ValueExpression: m["value_expression"].(string),
+			})
+		}
+	}
+
+	if v, ok := in["match_condition"].([]interface{}); ok {
+		for _, e := range v {
+			m := e.(map[string]interface{})
+			obj.MatchConditions = append(obj.MatchConditions, admissionregistrationv1beta1.MatchCondition{
+				Name:       m["name"].(string),
+				Expression: m["expression"].(string),
+			})
+		}
+	}
+
+	if v, ok := in["variable"].([]interface{}); ok {
+		for _, e := range v {
+			m := e.(map[string]interface{})
+			obj.Variables = append(obj.Variables, admissionregistrationv1beta1.Variable{
+				Name:       m["name"].(string),
+				Expression: m["expression"].(string),
+			})
+		}
+	}
+
+	return obj
+}
+
+func flattenValidatingAdmissionPolicyBindingSpec(in admissionregistrationv1beta1.ValidatingAdmissionPolicyBindingSpec) []interface{} {
+	att := map[string]interface{}{}
+
+	att["policy_name"] = in.PolicyName
+
+	if in.ParamRef != nil {
+		ref := map[string]interface{}{
+			"name":      in.ParamRef.Name,
+			"namespace": in.ParamRef.Namespace,
+		}
+		if in.ParamRef.Selector != nil {
+			ref["selector"] = flattenLabelSelector(in.ParamRef.Selector)
+		}
+		if in.ParamRef.ParameterNotFoundAction != nil {
+			ref["parameter_not_found_action"] = string(*in.ParamRef.ParameterNotFoundAction)
+		}
+		att["param_ref"] = []interface{}{ref}
+	}
+
+	att["match_resources"] = flattenMatchResources(in.MatchResources)
+
+	actions := []interface{}{}
+	for _, a := range in.ValidationActions {
+		actions = append(actions, string(a))
+	}
+	att["validation_actions"] = actions
+
+	return []interface{}{att}
+}
+
+func expandValidatingAdmissionPolicyBindingSpec(l []interface{}) admissionregistrationv1beta1.ValidatingAdmissionPolicyBindingSpec {
+	obj := admissionregistrationv1beta1.ValidatingAdmissionPolicyBindingSpec{}
+
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["policy_name"].(string); ok {
+		obj.PolicyName = v
+	}
+
+	if v, ok := in["param_ref"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		ref := &admissionregistrationv1beta1.ParamRef{
+			Name:      m["name"].(string),
+			Namespace: m["namespace"].(string),
+		}
+		if s, ok := m["selector"].([]interface{}); ok && len(s) > 0 {
+			ref.Selector = expandLabelSelector(s)
+		}
+		if a, ok := m["parameter_not_found_action"].(string); ok && a != "" {
+			action := admissionregistrationv1beta1.ParameterNotFoundActionType(a)
+			ref.ParameterNotFoundAction = &action
+		}
+		obj.ParamRef = ref
+	}
+
+	if v, ok := in["match_resources"].([]interface{}); ok {
+		obj.MatchResources = expandMatchResources(v)
+	}
+
+	if v, ok := in["validation_actions"].([]interface{}); ok {
+		for _, a := range v {
+			if a != nil {
+				obj.ValidationActions = append(obj.ValidationActions, admissionregistrationv1beta1.ValidationAction(a.(string)))
+			}
+		}
+	}
+
+	return obj
+}
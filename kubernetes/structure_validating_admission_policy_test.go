@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExpandFlattenValidatingAdmissionPolicySpec(t *testing.T) {
+	matchPolicy := admissionregistrationv1beta1.Equivalent
+	failurePolicy := admissionregistrationv1beta1.Fail
+	scope := admissionregistrationv1.ScopeType("*")
+	reason := metav1.StatusReasonInvalid
+	spec := admissionregistrationv1beta1.ValidatingAdmissionPolicySpec{
+		ParamKind: &admissionregistrationv1beta1.ParamKind{APIVersion: "v1", Kind: "ConfigMap"},
+		MatchConstraints: &admissionregistrationv1beta1.MatchResources{
+			MatchPolicy:       &matchPolicy,
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"environment": "test"}},
+			ResourceRules: []admissionregistrationv1beta1.NamedRuleWithOperations{
+				{
+					ResourceNames: []string{"web"},
+					RuleWithOperations: admissionregistrationv1.RuleWithOperations{
+						Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{"apps"},
+							APIVersions: []string{"v1"},
+							Resources:   []string{"deployments"},
+							Scope:       &scope,
+						},
+					},
+				},
+			},
+		},
+		Validations: []admissionregistrationv1beta1.Validation{
+			{Expression: "object.spec.replicas <= 5", Message: "too many replicas", Reason: &reason},
+		},
+		FailurePolicy:    &failurePolicy,
+		AuditAnnotations: []admissionregistrationv1beta1.AuditAnnotation{{Key: "replicas", ValueExpression: "string(object.spec.replicas)"}},
+		MatchConditions:  []admissionregistrationv1beta1.MatchCondition{{Name: "exclude-system", Expression: "true"}},
+		Variables:        []admissionregistrationv1beta1.Variable{{Name: "replicas", Expression: "object.spec.replicas"}},
+	}
+
+	d := resourceKubernetesValidatingAdmissionPolicyV1().TestResourceData()
+	if err := d.Set("spec", flattenValidatingAdmissionPolicySpec(spec)); err != nil {
+		t.Fatal(err)
+	}
+	out := expandValidatingAdmissionPolicySpec(d.Get("spec").([]interface{}))
+	if !reflect.DeepEqual(out, spec) {
+		t.Fatalf("Unexpected round trip.\nExpected: %#v\nGiven:    %#v", spec, out)
+	}
+}
+
+func TestExpandFlattenValidatingAdmissionPolicyBindingSpec(t *testing.T) {
+	action := admissionregistrationv1beta1.DenyAction
+	spec := admissionregistrationv1beta1.ValidatingAdmissionPolicyBindingSpec{
+		PolicyName: "replica-limit",
+		ParamRef: &admissionregistrationv1beta1.ParamRef{
+			Namespace:               "default",
+			Selector:                &metav1.LabelSelector{MatchLabels: map[string]string{"policy": "replica-limit"}},
+			ParameterNotFoundAction: &action,
+		},
+		ValidationActions: []admissionregistrationv1beta1.ValidationAction{admissionregistrationv1beta1.Warn, admissionregistrationv1beta1.Audit},
+	}
+
+	d := resourceKubernetesValidatingAdmissionPolicyBindingV1().TestResourceData()
+	if err := d.Set("spec", flattenValidatingAdmissionPolicyBindingSpec(spec)); err != nil {
+		t.Fatal(err)
+	}
+	out := expandValidatingAdmissionPolicyBindingSpec(d.Get("spec").([]interface{}))
+	if !reflect.DeepEqual(out, spec) {
+		t.Fatalf("Unexpected round trip.\nExpected: %#v\nGiven:    %#v", spec, out)
+	}
+}
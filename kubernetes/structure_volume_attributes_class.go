@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// VolumeAttributesClass, and the fields of PersistentVolumeClaim which refer to it, went GA
+// in Kubernetes 1.34 and are not part of k8s.io/api yet, so both are handled as unstructured objects.
+var (
+	volumeAttributesClassV1Kind = customResourceKind{group: "storage.k8s.io", version: "v1", resource: "volumeattributesclasses", kind: "VolumeAttributesClass"}
+	persistentVolumeClaimV1Kind = customResourceKind{version: "v1", resource: "persistentvolumeclaims", kind: "PersistentVolumeClaim", namespaced: true}
+)
+
+// volumeAttributesClassKind returns the kind of VolumeAttributesClass served by the cluster:
+// storage.k8s.io/v1, or storage.k8s.io/v1beta1 on clusters older than Kubernetes 1.34.
+func volumeAttributesClassKind(meta interface{}) (customResourceKind, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return customResourceKind{}, err
+	}
+	k := volumeAttributesClassV1Kind
+	resources, err := conn.Discovery().ServerResourcesForGroupVersion(k.groupVersion())
+	if err != nil && !errors.IsNotFound(err) {
+		return customResourceKind{}, err
+	}
+	served := false
+	if resources != nil {
+		for _, r := range resources.APIResources {
+			if r.Name == k.resource {
+				served = true
+				break
+			}
+		}
+	}
+	if !served {
+		k.version = "v1beta1"
+	}
+	log.Printf("[INFO] Using %s for %s", k.groupVersion(), k.resource)
+	return k, nil
+}
+
+func flattenPersistentVolumeClaimV1Status(in map[string]interface{}) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	att := map[string]interface{}{
+		"current_volume_attributes_class_name": unstructuredString(in["currentVolumeAttributesClassName"]),
+		"modify_volume_status":                 []interface{}{},
+	}
+	if s := unstructuredMap(in["modifyVolumeStatus"]); s != nil {
+		att["modify_volume_status"] = []interface{}{map[string]interface{}{
+			"status":                              unstructuredString(s["status"]),
+			"target_volume_attributes_class_name": unstructuredString(s["targetVolumeAttributesClassName"]),
+		}}
+	}
+	return []interface{}{att}
+}
+
+// persistentVolumeClaimModifyVolumeStatus checks whether the volume of a bound claim has been
+// modified to match the volume attributes class of its spec. Claims which are not bound yet
+// get their class when their volume is provisioned, so there is nothing to wait for.
+func persistentVolumeClaimModifyVolumeStatus(obj *unstructured.Unstructured) *retry.RetryError {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	if phase != "Bound" {
+		return nil
+	}
+	target, _, _ := unstructured.NestedString(obj.Object, "spec", "volumeAttributesClassName")
+	status, _, _ := unstructured.NestedString(obj.Object, "status", "modifyVolumeStatus", "status")
+	switch status {
+	case "":
+		current, _, _ := unstructured.NestedString(obj.Object, "status", "currentVolumeAttributesClassName")
+		if current == target {
+			return nil
+		}
+		return retry.RetryableError(fmt.Errorf("Waiting for the modification of the volume to volume attributes class %q to start", target))
+	case "Infeasible":
+		return retry.NonRetryableError(fmt.Errorf("The modification of the volume to volume attributes class %q is infeasible, see the events of the claim for details", target))
+	}
+	return retry.RetryableError(fmt.Errorf("Waiting for the modification of the volume to volume attributes class %q to finish: %s", target, status))
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFlattenPersistentVolumeClaimV1Status(t *testing.T) {
+	status := map[string]interface{}{
+		"phase":                            "Bound",
+		"currentVolumeAttributesClassName": "silver",
+		"modifyVolumeStatus": map[string]interface{}{
+			"status":                          "InProgress",
+			"targetVolumeAttributesClassName": "gold",
+		},
+	}
+	expected := []interface{}{map[string]interface{}{
+		"current_volume_attributes_class_name": "silver",
+		"modify_volume_status": []interface{}{map[string]interface{}{
+			"status":                              "InProgress",
+			"target_volume_attributes_class_name": "gold",
+		}},
+	}}
+
+	out := flattenPersistentVolumeClaimV1Status(status)
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Unexpected output.\nExpected: %#v\nGiven:    %#v", expected, out)
+	}
+}
+
+func TestPersistentVolumeClaimModifyVolumeStatus(t *testing.T) {
+	claim := func(phase, target, current, modifyStatus string) *unstructured.Unstructured {
+		status := map[string]interface{}{
+			"phase":                            phase,
+			"currentVolumeAttributesClassName": current,
+		}
+		if modifyStatus != "" {
+			status["modifyVolumeStatus"] = map[string]interface{}{
+				"status":                          modifyStatus,
+				"targetVolumeAttributesClassName": target,
+			}
+		}
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec":   map[string]interface{}{"volumeAttributesClassName": target},
+			"status": status,
+		}}
+	}
+
+	cases := []struct {
+		name      string
+		claim     *unstructured.Unstructured
+		done      bool
+		retryable bool
+	}{
+		{
+			name:  "pending claim",
+			claim: claim("Pending", "gold", "", ""),
+			done:  true,
+		},
+		{
+			name:  "modified",
+			claim: claim("Bound", "gold", "gold", ""),
+			done:  true,
+		},
+		{
+			name:      "not started",
+			claim:     claim("Bound", "gold", "silver", ""),
+			retryable: true,
+		},
+		{
+			name:      "in progress",
+			claim:     claim("Bound", "gold", "silver", "InProgress"),
+			retryable: true,
+		},
+		{
+			name:  "infeasible",
+			claim: claim("Bound", "gold", "silver", "Infeasible"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := persistentVolumeClaimModifyVolumeStatus(tc.claim)
+			if tc.done {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if err.Retryable != tc.retryable {
+				t.Fatalf("expected retryable to be %t, got %t: %s", tc.retryable, err.Retryable, err.Err)
+			}
+		})
+	}
+}
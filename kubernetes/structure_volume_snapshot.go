@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// The volume snapshot API is made of CRDs installed along with the snapshot controller,
+// which are not part of k8s.io/api.
+const volumeSnapshotGroup = "snapshot.storage.k8s.io"
+
+var (
+	volumeSnapshotClassV1Kind   = customResourceKind{group: volumeSnapshotGroup, version: "v1", resource: "volumesnapshotclasses", kind: "VolumeSnapshotClass"}
+	volumeSnapshotV1Kind        = customResourceKind{group: volumeSnapshotGroup, version: "v1", resource: "volumesnapshots", kind: "VolumeSnapshot", namespaced: true}
+	volumeSnapshotContentV1Kind = customResourceKind{group: volumeSnapshotGroup, version: "v1", resource: "volumesnapshotcontents", kind: "VolumeSnapshotContent"}
+)
+
+// volumeSnapshotReadyToUse checks that the status of a VolumeSnapshot or VolumeSnapshotContent
+// reports the snapshot as ready to be used to restore a volume
+func volumeSnapshotReadyToUse(obj *unstructured.Unstructured) error {
+	ready, _, err := unstructured.NestedBool(obj.Object, "status", "readyToUse")
+	if err != nil {
+		return err
+	}
+	if ready {
+		return nil
+	}
+	if msg, _, _ := unstructured.NestedString(obj.Object, "status", "error", "message"); msg != "" {
+		return fmt.Errorf("%s", msg)
+	}
+	return fmt.Errorf("readyToUse is not reported as true")
+}
+
+func flattenVolumeSnapshotError(in interface{}) []interface{} {
+	e := unstructuredMap(in)
+	if e == nil {
+		return []interface{}{}
+	}
+	return []interface{}{map[string]interface{}{
+		"message": unstructuredString(e["message"]),
+		"time":    unstructuredString(e["time"]),
+	}}
+}
+
+// Volume snapshot
+
+func expandVolumeSnapshotV1Spec(l []interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	in, ok := firstSchemaBlock(l)
+	if !ok {
+		return out
+	}
+	source := map[string]interface{}{}
+	if s, ok := firstSchemaBlock(in["source"]); ok {
+		putUnstructuredString(source, "persistentVolumeClaimName", s["persistent_volume_claim_name"])
+		putUnstructuredString(source, "volumeSnapshotContentName", s["volume_snapshot_content_name"])
+	}
+	out["source"] = source
+	putUnstructuredString(out, "volumeSnapshotClassName", in["volume_snapshot_class_name"])
+	return out
+}
+
+func flattenVolumeSnapshotV1Spec(in map[string]interface{}) []interface{} {
+	source := unstructuredMap(in["source"])
+	return []interface{}{map[string]interface{}{
+		"source": []interface{}{map[string]interface{}{
+			"persistent_volume_claim_name": unstructuredString(source["persistentVolumeClaimName"]),
+			"volume_snapshot_content_name": unstructuredString(source["volumeSnapshotContentName"]),
+		}},
+		"volume_snapshot_class_name": unstructuredString(in["volumeSnapshotClassName"]),
+	}}
+}
+
+func flattenVolumeSnapshotV1Status(in map[string]interface{}) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	ready, _ := in["readyToUse"].(bool)
+	return []interface{}{map[string]interface{}{
+		"bound_volume_snapshot_content_name": unstructuredString(in["boundVolumeSnapshotContentName"]),
+		"creation_time":                      unstructuredString(in["creationTime"]),
+		"error":                              flattenVolumeSnapshotError(in["error"]),
+		"ready_to_use":                       ready,
+		"restore_size":                       unstructuredString(in["restoreSize"]),
+	}}
+}
+
+// Volume snapshot content
+
+func expandVolumeSnapshotContentV1Spec(l []interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	in, ok := firstSchemaBlock(l)
+	if !ok {
+		return out
+	}
+	putUnstructuredString(out, "deletionPolicy", in["deletion_policy"])
+	putUnstructuredString(out, "driver", in["driver"])
+	source := map[string]interface{}{}
+	if s, ok := firstSchemaBlock(in["source"]); ok {
+		putUnstructuredString(source, "snapshotHandle", s["snapshot_handle"])
+		putUnstructuredString(source, "volumeHandle", s["volume_handle"])
+	}
+	out["source"] = source
+	putUnstructuredString(out, "sourceVolumeMode", in["source_volume_mode"])
+	putUnstructuredString(out, "volumeSnapshotClassName", in["volume_snapshot_class_name"])
+	ref := map[string]interface{}{}
+	if r, ok := firstSchemaBlock(in["volume_snapshot_ref"]); ok {
+		putUnstructuredString(ref, "name", r["name"])
+		putUnstructuredString(ref, "namespace", r["namespace"])
+	}
+	out["volumeSnapshotRef"] = ref
+	return out
+}
+
+func flattenVolumeSnapshotContentV1Spec(in map[string]interface{}) []interface{} {
+	source := unstructuredMap(in["source"])
+	ref := unstructuredMap(in["volumeSnapshotRef"])
+	return []interface{}{map[string]interface{}{
+		"deletion_policy": unstructuredString(in["deletionPolicy"]),
+		"driver":          unstructuredString(in["driver"]),
+		"source": []interface{}{map[string]interface{}{
+			"snapshot_handle": unstructuredString(source["snapshotHandle"]),
+			"volume_handle":   unstructuredString(source["volumeHandle"]),
+		}},
+		"source_volume_mode":         unstructuredString(in["sourceVolumeMode"]),
+		"volume_snapshot_class_name": unstructuredString(in["volumeSnapshotClassName"]),
+		"volume_snapshot_ref": []interface{}{map[string]interface{}{
+			"name":      unstructuredString(ref["name"]),
+			"namespace": unstructuredString(ref["namespace"]),
+			"uid":       unstructuredString(ref["uid"]),
+		}},
+	}}
+}
+
+func flattenVolumeSnapshotContentV1Status(in map[string]interface{}) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	ready, _ := in["readyToUse"].(bool)
+	// Unlike the VolumeSnapshot status, the creation time of the content is reported in nanoseconds
+	// since the epoch and its restore size in bytes
+	creationTime := ""
+	if ns := unstructuredInt(in["creationTime"]); ns != 0 {
+		creationTime = time.Unix(0, int64(ns)).UTC().Format(time.RFC3339)
+	}
+	return []interface{}{map[string]interface{}{
+		"creation_time":   creationTime,
+		"error":           flattenVolumeSnapshotError(in["error"]),
+		"ready_to_use":    ready,
+		"restore_size":    unstructuredInt(in["restoreSize"]),
+		"snapshot_handle": unstructuredString(in["snapshotHandle"]),
+	}}
+}
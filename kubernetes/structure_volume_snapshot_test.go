@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestExpandFlattenVolumeSnapshotV1Spec(t *testing.T) {
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{
+			"persistentVolumeClaimName": "data",
+		},
+		"volumeSnapshotClassName": "csi-hostpath-snapclass",
+	}
+
+	d := resourceKubernetesVolumeSnapshotV1().TestResourceData()
+	if err := d.Set("spec", flattenVolumeSnapshotV1Spec(spec)); err != nil {
+		t.Fatal(err)
+	}
+	out := expandVolumeSnapshotV1Spec(d.Get("spec").([]interface{}))
+	if !reflect.DeepEqual(out, spec) {
+		t.Fatalf("Unexpected round trip.\nExpected: %#v\nGiven:    %#v", spec, out)
+	}
+}
+
+func TestExpandFlattenVolumeSnapshotContentV1Spec(t *testing.T) {
+	spec := map[string]interface{}{
+		"deletionPolicy": "Retain",
+		"driver":         "hostpath.csi.k8s.io",
+		"source": map[string]interface{}{
+			"snapshotHandle": "7bdd0de3-aaeb-11e8-9aae-0242ac110002",
+		},
+		"sourceVolumeMode":        "Filesystem",
+		"volumeSnapshotClassName": "csi-hostpath-snapclass",
+		"volumeSnapshotRef": map[string]interface{}{
+			"name":      "restored",
+			"namespace": "default",
+		},
+	}
+
+	d := resourceKubernetesVolumeSnapshotContentV1().TestResourceData()
+	if err := d.Set("spec", flattenVolumeSnapshotContentV1Spec(spec)); err != nil {
+		t.Fatal(err)
+	}
+	out := expandVolumeSnapshotContentV1Spec(d.Get("spec").([]interface{}))
+	if !reflect.DeepEqual(out, spec) {
+		t.Fatalf("Unexpected round trip.\nExpected: %#v\nGiven:    %#v", spec, out)
+	}
+}
+
+func TestFlattenVolumeSnapshotContentV1Status(t *testing.T) {
+	status := map[string]interface{}{
+		"creationTime":   int64(1700000000000000000),
+		"readyToUse":     true,
+		"restoreSize":    int64(1073741824),
+		"snapshotHandle": "7bdd0de3-aaeb-11e8-9aae-0242ac110002",
+	}
+	expected := []interface{}{map[string]interface{}{
+		"creation_time":   "2023-11-14T22:13:20Z",
+		"error":           []interface{}{},
+		"ready_to_use":    true,
+		"restore_size":    1073741824,
+		"snapshot_handle": "7bdd0de3-aaeb-11e8-9aae-0242ac110002",
+	}}
+
+	out := flattenVolumeSnapshotContentV1Status(status)
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Unexpected output.\nExpected: %#v\nGiven:    %#v", expected, out)
+	}
+}
+
+func TestVolumeSnapshotReadyToUse(t *testing.T) {
+	snapshot := func(status map[string]interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		if status != nil {
+			obj.Object["status"] = status
+		}
+		return obj
+	}
+
+	cases := []struct {
+		name     string
+		snapshot *unstructured.Unstructured
+		err      string
+	}{
+		{
+			name:     "no status",
+			snapshot: snapshot(nil),
+			err:      "readyToUse is not reported as true",
+		},
+		{
+			name:     "ready",
+			snapshot: snapshot(map[string]interface{}{"readyToUse": true}),
+		},
+		{
+			name:     "not ready",
+			snapshot: snapshot(map[string]interface{}{"readyToUse": false}),
+			err:      "readyToUse is not reported as true",
+		},
+		{
+			name: "error",
+			snapshot: snapshot(map[string]interface{}{
+				"readyToUse": false,
+				"error":      map[string]interface{}{"message": "failed to take snapshot of the volume"},
+			}),
+			err: "failed to take snapshot of the volume",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := volumeSnapshotReadyToUse(tc.snapshot)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.err {
+				t.Fatalf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
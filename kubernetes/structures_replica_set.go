@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/utils/ptr"
+)
+
+func flattenReplicaSetSpec(in appsv1.ReplicaSetSpec) ([]interface{}, error) {
+	att := make(map[string]interface{})
+	att["min_ready_seconds"] = in.MinReadySeconds
+
+	if in.Replicas != nil {
+		att["replicas"] = strconv.Itoa(int(*in.Replicas))
+	}
+
+	if in.Selector != nil {
+		att["selector"] = flattenLabelSelector(in.Selector)
+	}
+
+	template, err := flattenPodTemplateSpec(in.Template)
+	if err != nil {
+		return nil, err
+	}
+	att["template"] = template
+
+	return []interface{}{att}, nil
+}
+
+func expandReplicaSetSpec(rs []interface{}) (*appsv1.ReplicaSetSpec, error) {
+	obj := &appsv1.ReplicaSetSpec{}
+
+	if len(rs) == 0 || rs[0] == nil {
+		return obj, nil
+	}
+
+	in := rs[0].(map[string]interface{})
+
+	obj.MinReadySeconds = int32(in["min_ready_seconds"].(int))
+	if v, ok := in["replicas"].(string); ok && v != "" {
+		i, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return obj, err
+		}
+		obj.Replicas = ptr.To(int32(i))
+	}
+
+	if v, ok := in["selector"].([]interface{}); ok && len(v) > 0 {
+		obj.Selector = expandLabelSelector(v)
+	}
+
+	template, err := expandPodTemplate(in["template"].([]interface{}))
+	if err != nil {
+		return obj, err
+	}
+	obj.Template = *template
+
+	return obj, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+// The ValidatingAdmissionPolicy API is served at admissionregistration.k8s.io/v1 from Kubernetes 1.30,
+// and at v1beta1 before that. The version of k8s.io/api in use has no v1 types for it, so the
+// v1beta1 types, which have the same schema, are sent through the dynamic client.
+const (
+	validatingAdmissionPolicyResource        = "validatingadmissionpolicies"
+	validatingAdmissionPolicyBindingResource = "validatingadmissionpolicybindings"
+)
+
+// admissionPolicyClient is a client of ValidatingAdmissionPolicies or their bindings
+type admissionPolicyClient struct {
+	resource dynamic.ResourceInterface
+	gvk      apimachineryschema.GroupVersionKind
+}
+
+// newAdmissionPolicyClient returns a client of the given resource at the most recent version served by the cluster
+func newAdmissionPolicyClient(meta interface{}, resource, kind string) (*admissionPolicyClient, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	gv := apimachineryschema.GroupVersion{Group: "admissionregistration.k8s.io", Version: "v1"}
+	resources, err := conn.Discovery().ServerResourcesForGroupVersion(gv.String())
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	served := false
+	if resources != nil {
+		for _, r := range resources.APIResources {
+			if r.Name == resource {
+				served = true
+				break
+			}
+		}
+	}
+	if !served {
+		gv.Version = "v1beta1"
+	}
+	log.Printf("[INFO] Using %s for %s", gv, resource)
+	return &admissionPolicyClient{
+		resource: dc.Resource(gv.WithResource(resource)),
+		gvk:      gv.WithKind(kind),
+	}, nil
+}
+
+func (c *admissionPolicyClient) Get(ctx context.Context, name string, out interface{}) error {
+	res, err := c.resource.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(res.Object, out)
+}
+
+func (c *admissionPolicyClient) Create(ctx context.Context, obj interface{}, out interface{}) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(c.gvk)
+	res, err := c.resource.Create(ctx, u, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(res.Object, out)
+}
+
+func (c *admissionPolicyClient) Patch(ctx context.Context, name string, ops PatchOperations, out interface{}) error {
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Updating %s %q: %v", c.gvk.Kind, name, string(data))
+	res, err := c.resource.Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(res.Object, out)
+}
+
+func (c *admissionPolicyClient) Delete(ctx context.Context, name string) error {
+	return c.resource.Delete(ctx, name, metav1.DeleteOptions{})
+}
@@ -5,4 +5,6 @@ package manifest
 
 const (
 	PreserveUnknownFieldsLabel string = "x-kubernetes-preserve-unknown-fields"
+	IntOrStringLabel           string = "x-kubernetes-int-or-string"
+	EmbeddedResourceLabel      string = "x-kubernetes-embedded-resource"
 )
@@ -16,7 +16,7 @@ func DeepUnknown(t tftypes.Type, v tftypes.Value, p *tftypes.AttributePath) (tft
 		return tftypes.Value{}, fmt.Errorf("type cannot be nil")
 	}
 	if !v.IsKnown() {
-		return tftypes.NewValue(t, tftypes.UnknownValue), nil
+		return newUnknown(t), nil
 	}
 	switch {
 	case t.Is(tftypes.Object{}):
@@ -40,7 +40,7 @@ func DeepUnknown(t tftypes.Type, v tftypes.Value, p *tftypes.AttributePath) (tft
 		return tftypes.NewValue(tftypes.Object{AttributeTypes: otypes}, ovals), nil
 	case t.Is(tftypes.Map{}):
 		if v.IsNull() {
-			return tftypes.NewValue(t, tftypes.UnknownValue), nil
+			return newUnknown(t), nil
 		}
 		var vals map[string]tftypes.Value
 		err := v.As(&vals)
@@ -58,7 +58,7 @@ func DeepUnknown(t tftypes.Type, v tftypes.Value, p *tftypes.AttributePath) (tft
 		return tftypes.NewValue(t, vals), nil
 	case t.Is(tftypes.Tuple{}):
 		if v.IsNull() {
-			return tftypes.NewValue(t, tftypes.UnknownValue), nil
+			return newUnknown(t), nil
 		}
 		atts := t.(tftypes.Tuple).ElementTypes
 		if len(v.Type().(tftypes.Tuple).ElementTypes) != len(atts) {
@@ -86,7 +86,7 @@ func DeepUnknown(t tftypes.Type, v tftypes.Value, p *tftypes.AttributePath) (tft
 		return tftypes.NewValue(tftypes.Tuple{ElementTypes: atts}, vals), nil
 	case t.Is(tftypes.List{}) || t.Is(tftypes.Set{}):
 		if v.IsNull() {
-			return tftypes.NewValue(t, tftypes.UnknownValue), nil
+			return newUnknown(t), nil
 		}
 		vals := make([]tftypes.Value, 0)
 		err := v.As(&vals)
@@ -113,10 +113,41 @@ func DeepUnknown(t tftypes.Type, v tftypes.Value, p *tftypes.AttributePath) (tft
 		if v.IsKnown() && !v.IsNull() {
 			return v, nil
 		}
-		return tftypes.NewValue(t, tftypes.UnknownValue), nil
+		return newUnknown(t), nil
 	}
 }
 
+// newUnknown creates an unknown value of a given type. When values of the type can differ
+// in shape, the unknown value is dynamic, so that it can later be replaced by any of them.
+func newUnknown(t tftypes.Type) tftypes.Value {
+	if !hasFixedShape(t) {
+		return tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue)
+	}
+	return tftypes.NewValue(t, tftypes.UnknownValue)
+}
+
+// hasFixedShape reports whether all values of a type share the exact same type,
+// which isn't the case for dynamic values or tuples standing in for lists of dynamic values.
+func hasFixedShape(t tftypes.Type) bool {
+	switch {
+	case t.Is(tftypes.DynamicPseudoType), t.Is(tftypes.Tuple{}):
+		return false
+	case t.Is(tftypes.Object{}):
+		for _, at := range t.(tftypes.Object).AttributeTypes {
+			if !hasFixedShape(at) {
+				return false
+			}
+		}
+	case t.Is(tftypes.List{}):
+		return hasFixedShape(t.(tftypes.List).ElementType)
+	case t.Is(tftypes.Set{}):
+		return hasFixedShape(t.(tftypes.Set).ElementType)
+	case t.Is(tftypes.Map{}):
+		return hasFixedShape(t.(tftypes.Map).ElementType)
+	}
+	return true
+}
+
 // UnknownToNull replaces all unknown values in a deep structure with null
 func UnknownToNull(v tftypes.Value) tftypes.Value {
 	if !v.IsKnown() {
@@ -299,6 +299,29 @@ func TestDeepUnknown(t *testing.T) {
 				},
 			),
 		},
+		"object-dynamic-nil": {
+			In: deepUnknownTestSampleInput{
+				T: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+					"name":     tftypes.String,
+					"config":   tftypes.DynamicPseudoType,
+					"versions": tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.DynamicPseudoType}},
+				}},
+				V: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				}}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "foo"),
+				}),
+			},
+			Out: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"name":     tftypes.String,
+				"config":   tftypes.DynamicPseudoType,
+				"versions": tftypes.DynamicPseudoType,
+			}}, map[string]tftypes.Value{
+				"name":     tftypes.NewValue(tftypes.String, "foo"),
+				"config":   tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+				"versions": tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+			}),
+		},
 	}
 	for n, s := range samples {
 		t.Run(n, func(t *testing.T) {
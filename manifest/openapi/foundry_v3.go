@@ -4,21 +4,37 @@
 package openapi
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sopenapi "k8s.io/client-go/openapi"
 )
 
+// NewFoundryFromSpecV3 creates a new tftypes.Type foundry from an OpenAPI v3 spec document
+// * spec argument should be a valid OpenAPI v3 JSON document
 func NewFoundryFromSpecV3(spec []byte) (Foundry, error) {
-	loader := openapi3.NewLoader()
-	oapi3, err := loader.LoadFromData(spec)
+	return newFoapiv3(spec)
+}
+
+func newFoapiv3(spec []byte) (*foapiv3, error) {
+	// References are deliberately left unresolved, the same as for OpenAPI v2 specs:
+	// resolving them creates cycles in recursive schemas such as JSONSchemaProps.
+	var oapi3 openapi3.T
+	err := json.Unmarshal(spec, &oapi3)
 	if err != nil {
 		return nil, err
 	}
-	return &foapiv3{doc: oapi3}, nil
+	f := &foapiv3{doc: &oapi3}
+	err = f.buildGvkIndex()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build GVK index when creating new foundry: %s", err)
+	}
+	return f, nil
 }
 
 func SchemaToSpec(key string, crschema map[string]interface{}) map[string]interface{} {
@@ -45,22 +61,136 @@ type foapiv3 struct {
 	doc       *openapi3.T
 	gate      sync.Mutex
 	typeCache sync.Map
+	gvkIndex  sync.Map
 }
 
-func (f *foapiv3) GetTypeByGVK(_ schema.GroupVersionKind) (tftypes.Type, map[string]string, error) {
+// GetTypeByGVK looks up a type by its GVK in the components section of
+// the OpenAPI spec and returns its (nearest) tftypes.Type equivalent.
+// Documents produced by SchemaToSpec with an empty key hold a single
+// schema, which is returned regardless of the GVK.
+func (f *foapiv3) GetTypeByGVK(gvk schema.GroupVersionKind) (tftypes.Type, map[string]string, error) {
 	f.gate.Lock()
 	defer f.gate.Unlock()
 
 	var hints map[string]string = make(map[string]string)
 	ap := tftypes.AttributePath{}
 
-	sref := f.doc.Components.Schemas[""]
+	var id string
+	if i, ok := f.gvkIndex.Load(gvk); ok {
+		id = i.(string)
+	} else if gvk == ObjectMetaGVK {
+		// ObjectMeta is not tagged with "x-kubernetes-group-version-kind", see foapiv2
+		id = "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"
+	} else if _, ok := f.doc.Components.Schemas[""]; !ok {
+		return nil, hints, fmt.Errorf("%v resource not found in OpenAPI index", gvk)
+	}
+
+	t, err := f.getTypeByID(id, hints, ap)
+	return t, hints, err
+}
+
+func (f *foapiv3) getTypeByID(id string, h map[string]string, ap tftypes.AttributePath) (tftypes.Type, error) {
+	sref, ok := f.doc.Components.Schemas[id]
+	if !ok {
+		return nil, errors.New("invalid type identifier")
+	}
+	if sref == nil {
+		return nil, errors.New("invalid type reference (nil)")
+	}
 
 	sch, err := resolveSchemaRef(sref, f.doc.Components.Schemas)
 	if err != nil {
-		return nil, hints, fmt.Errorf("failed to resolve schema: %s", err)
+		return nil, fmt.Errorf("failed to resolve schema: %s", err)
+	}
+
+	return getTypeFromSchema(sch, 50, &(f.typeCache), f.doc.Components.Schemas, ap, h)
+}
+
+// buildGvkIndex builds the reverse lookup index that associates each GVK
+// to its corresponding string key in the Components.Schemas map
+func (f *foapiv3) buildGvkIndex() error {
+	for did, dRef := range f.doc.Components.Schemas {
+		if dRef == nil || dRef.Value == nil {
+			continue
+		}
+		ex, ok := dRef.Value.Extensions["x-kubernetes-group-version-kind"]
+		if !ok {
+			continue
+		}
+		gvk := []schema.GroupVersionKind{}
+		err := json.Unmarshal(([]byte)(ex.(json.RawMessage)), &gvk)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshall GVK from OpenAPI schema extention: %v", err)
+		}
+		for i := range gvk {
+			f.gvkIndex.Store(gvk[i], did)
+		}
+	}
+	return nil
+}
+
+// NewFoundryFromOpenAPIV3 creates a new tftypes.Type foundry backed by the
+// per group-version documents served by the /openapi/v3 endpoint of a cluster.
+// Documents are only retrieved the first time a type from their group-version
+// is requested and are kept for the lifetime of the foundry.
+func NewFoundryFromOpenAPIV3(client k8sopenapi.Client) (Foundry, error) {
+	paths, err := client.Paths()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OpenAPI v3 group-versions: %s", err)
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("server publishes no OpenAPI v3 group-versions")
+	}
+	return &foapiv3gv{
+		paths: paths,
+		docs:  make(map[string]*foapiv3),
+	}, nil
+}
+
+type foapiv3gv struct {
+	paths map[string]k8sopenapi.GroupVersion
+	docs  map[string]*foapiv3
+	gate  sync.Mutex
+}
+
+// GetTypeByGVK loads the OpenAPI v3 document of the GVK's group-version, if
+// not already loaded, and looks the type up in it
+func (f *foapiv3gv) GetTypeByGVK(gvk schema.GroupVersionKind) (tftypes.Type, map[string]string, error) {
+	doc, err := f.getDocument(gvk.GroupVersion())
+	if err != nil {
+		return nil, map[string]string{}, err
 	}
+	return doc.GetTypeByGVK(gvk)
+}
+
+func (f *foapiv3gv) getDocument(gv schema.GroupVersion) (*foapiv3, error) {
+	f.gate.Lock()
+	defer f.gate.Unlock()
 
-	tftype, err := getTypeFromSchema(sch, 50, &(f.typeCache), f.doc.Components.Schemas, ap, hints)
-	return tftype, hints, err
+	p := groupVersionPath(gv)
+	if doc, ok := f.docs[p]; ok {
+		return doc, nil
+	}
+	gvp, ok := f.paths[p]
+	if !ok {
+		return nil, fmt.Errorf("%v group-version not found in OpenAPI v3 index", gv)
+	}
+	spec, err := gvp.Schema("application/json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OpenAPI v3 spec for %q: %s", p, err)
+	}
+	doc, err := newFoapiv3(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI v3 spec for %q: %s", p, err)
+	}
+	f.docs[p] = doc
+	return doc, nil
+}
+
+// groupVersionPath returns the key of a group-version in the /openapi/v3 index
+func groupVersionPath(gv schema.GroupVersion) string {
+	if gv.Group == "" {
+		return "api/" + gv.Version
+	}
+	return "apis/" + gv.Group + "/" + gv.Version
 }
@@ -6,6 +6,10 @@ package openapi
 import (
 	"encoding/json"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/openapi/openapitest"
 )
 
 func TestNewFoundryFromSpecV3(t *testing.T) {
@@ -66,3 +70,122 @@ func TestNewFoundryFromSpecV3(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestFoundryOAPIv3GetTypeByGVK(t *testing.T) {
+	f, err := NewFoundryFromOpenAPIV3(openapitest.NewEmbeddedFileClient())
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+
+	samples := map[string]struct {
+		gvk   schema.GroupVersionKind
+		check func(t *testing.T, typ tftypes.Type, hints map[string]string)
+	}{
+		"core/v1/ConfigMap": {
+			gvk: schema.GroupVersionKind{Group: "", Version: "v1", Kind: "ConfigMap"},
+			check: func(t *testing.T, typ tftypes.Type, _ map[string]string) {
+				ot := typ.(tftypes.Object)
+				if !ot.AttributeTypes["data"].Equal(tftypes.Map{ElementType: tftypes.String}) {
+					t.Errorf("unexpected type for data: %s", ot.AttributeTypes["data"])
+				}
+				if !ot.AttributeTypes["metadata"].Is(tftypes.Object{}) {
+					t.Errorf("metadata is not an object: %s", ot.AttributeTypes["metadata"])
+				}
+			},
+		},
+		"apps/v1/Deployment": {
+			gvk: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			check: func(t *testing.T, typ tftypes.Type, hints map[string]string) {
+				ot := typ.(tftypes.Object)
+				spec, ok := ot.AttributeTypes["spec"].(tftypes.Object)
+				if !ok {
+					t.Fatalf("spec is not an object: %s", ot.AttributeTypes["spec"])
+				}
+				if !spec.AttributeTypes["replicas"].Is(tftypes.Number) {
+					t.Errorf("unexpected type for spec.replicas: %s", spec.AttributeTypes["replicas"])
+				}
+				mu := tftypes.NewAttributePath().
+					WithAttributeName("spec").
+					WithAttributeName("strategy").
+					WithAttributeName("rollingUpdate").
+					WithAttributeName("maxUnavailable")
+				if hints[mu.String()] != "io.k8s.apimachinery.pkg.util.intstr.IntOrString" {
+					t.Errorf("missing IntOrString hint on %s", mu.String())
+				}
+			},
+		},
+		"ObjectMeta": {
+			gvk: ObjectMetaGVK,
+			check: func(t *testing.T, typ tftypes.Type, _ map[string]string) {
+				ot := typ.(tftypes.Object)
+				if !ot.AttributeTypes["name"].Is(tftypes.String) {
+					t.Errorf("unexpected type for name: %s", ot.AttributeTypes["name"])
+				}
+			},
+		},
+	}
+
+	for name, s := range samples {
+		t.Run(name, func(t *testing.T) {
+			typ, hints, err := f.GetTypeByGVK(s.gvk)
+			if err != nil {
+				t.Fatalf("Error: %+v", err)
+			}
+			if !typ.Is(tftypes.Object{}) {
+				t.Fatalf("type is not an object: %s", typ)
+			}
+			s.check(t, typ, hints)
+		})
+	}
+
+	// documents are loaded once per group-version
+	if len(f.(*foapiv3gv).docs) != 2 {
+		t.Errorf("expected 2 cached documents, got %d", len(f.(*foapiv3gv).docs))
+	}
+
+	_, _, err = f.GetTypeByGVK(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"})
+	if err == nil {
+		t.Error("expected an error for an unknown group-version")
+	}
+	_, _, err = f.GetTypeByGVK(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Widget"})
+	if err == nil {
+		t.Error("expected an error for an unknown kind")
+	}
+}
+
+func TestFoundryOAPIv3RecursiveSchema(t *testing.T) {
+	spec := `{
+  "openapi": "3.0.0",
+  "info": {"title": "test", "version": "1.0.0"},
+  "paths": {},
+  "components": {
+    "schemas": {
+      "io.k8s.apiextensions-apiserver.pkg.apis.apiextensions.v1.JSONSchemaProps": {
+        "type": "object",
+        "properties": {
+          "items": {"allOf": [{"$ref": "#/components/schemas/io.k8s.apiextensions-apiserver.pkg.apis.apiextensions.v1.JSONSchemaProps"}]}
+        }
+      },
+      "io.k8s.apiextensions-apiserver.pkg.apis.apiextensions.v1.CustomResourceValidation": {
+        "type": "object",
+        "x-kubernetes-group-version-kind": [{"group": "apiextensions.k8s.io", "version": "v1", "kind": "CustomResourceValidation"}],
+        "properties": {
+          "openAPIV3Schema": {"allOf": [{"$ref": "#/components/schemas/io.k8s.apiextensions-apiserver.pkg.apis.apiextensions.v1.JSONSchemaProps"}]}
+        }
+      }
+    }
+  }
+}`
+	f, err := NewFoundryFromSpecV3([]byte(spec))
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	typ, _, err := f.GetTypeByGVK(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceValidation"})
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	expected := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"openAPIV3Schema": tftypes.DynamicPseudoType}}
+	if !typ.Equal(expected) {
+		t.Errorf("unexpected type: %s", typ)
+	}
+}
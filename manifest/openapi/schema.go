@@ -18,7 +18,7 @@ import (
 
 func resolveSchemaRef(ref *openapi3.SchemaRef, defs map[string]*openapi3.SchemaRef) (*openapi3.Schema, error) {
 	if ref.Value != nil {
-		return ref.Value, nil
+		return unwrapAllOf(ref.Value, defs)
 	}
 
 	rp := strings.Split(ref.Ref, "/")
@@ -50,6 +50,15 @@ func resolveSchemaRef(ref *openapi3.SchemaRef, defs map[string]*openapi3.SchemaR
 	return resolveSchemaRef(nref, defs)
 }
 
+// unwrapAllOf resolves the single-element "allOf" wrapper that OpenAPI v3 documents
+// use to attach a description or default value to a reference
+func unwrapAllOf(s *openapi3.Schema, defs map[string]*openapi3.SchemaRef) (*openapi3.Schema, error) {
+	if s.Type == "" && len(s.AllOf) == 1 && s.Properties == nil && s.AdditionalProperties == nil && s.Items == nil {
+		return resolveSchemaRef(s.AllOf[0], defs)
+	}
+	return s, nil
+}
+
 func getTypeFromSchema(elem *openapi3.Schema, stackdepth uint64, typeCache *sync.Map, defs map[string]*openapi3.SchemaRef, ap tftypes.AttributePath, th map[string]string) (tftypes.Type, error) {
 	if stackdepth == 0 {
 		// this is a hack to overcome the inability to express recursion in tftypes
@@ -66,18 +75,21 @@ func getTypeFromSchema(elem *openapi3.Schema, stackdepth uint64, typeCache *sync
 
 	// Check if attribute type is tagged as 'x-kubernetes-preserve-unknown-fields' in OpenAPI.
 	// If so, we add a type hint to indicate this and return DynamicPseudoType for this attribute,
-	// since we have no further structural information about it.
-	if xpufJSON, ok := elem.Extensions[manifest.PreserveUnknownFieldsLabel]; ok {
-		var xpuf bool
-		v, err := xpufJSON.(json.RawMessage).MarshalJSON()
-		if err == nil {
-			err = json.Unmarshal(v, &xpuf)
-			if err == nil && xpuf {
-				th[ap.String()] = manifest.PreserveUnknownFieldsLabel
-			}
+	// since the properties it declares (if any) don't constrain the shape of its value.
+	// The resource root keeps its declared structure, as it has to remain an object.
+	if isExtensionSet(elem, manifest.PreserveUnknownFieldsLabel) {
+		th[ap.String()] = manifest.PreserveUnknownFieldsLabel
+		if len(ap.Steps()) > 0 {
+			return tftypes.DynamicPseudoType, nil
 		}
 	}
 
+	// Values tagged as 'x-kubernetes-int-or-string' are kept as strings in state, regardless of their declared type
+	if isExtensionSet(elem, manifest.IntOrStringLabel) {
+		th[ap.String()] = "io.k8s.apimachinery.pkg.util.intstr.IntOrString"
+		return tftypes.String, nil
+	}
+
 	// check if type is in cache
 	// HACK: this is temporarily disabled to diagnose a cache corruption issue.
 	// if herr == nil {
@@ -102,15 +114,14 @@ func getTypeFromSchema(elem *openapi3.Schema, stackdepth uint64, typeCache *sync
 		return tftypes.Number, nil
 
 	case "":
-		if xv, ok := elem.Extensions["x-kubernetes-int-or-string"]; ok {
-			xb, err := xv.(json.RawMessage).MarshalJSON()
-			if err != nil {
-				return tftypes.DynamicPseudoType, nil
-			}
-			var x bool
-			err = json.Unmarshal(xb, &x)
-			if err == nil && x {
-				th[ap.String()] = "io.k8s.apimachinery.pkg.util.intstr.IntOrString"
+		// OpenAPI v3 documents describe IntOrString and Quantity as a "oneOf" of scalar types,
+		// where OpenAPI v2 documents declare them as plain strings.
+		if elem.Format == "int-or-string" {
+			th[ap.String()] = "io.k8s.apimachinery.pkg.util.intstr.IntOrString"
+			return tftypes.String, nil
+		}
+		for _, o := range elem.OneOf {
+			if o.Value != nil && o.Value.Type == "string" {
 				return tftypes.String, nil
 			}
 		}
@@ -152,9 +163,15 @@ func getTypeFromSchema(elem *openapi3.Schema, stackdepth uint64, typeCache *sync
 		}
 
 	case "object":
+		embedded := isExtensionSet(elem, manifest.EmbeddedResourceLabel)
 
 		switch {
-		case elem.Properties != nil && elem.AdditionalProperties == nil:
+		case elem.Properties != nil && (elem.AdditionalProperties != nil || isAdditionalPropertiesAllowed(elem)):
+			// declared properties mixed with arbitrary ones - the shape is only known from the value
+			t = tftypes.DynamicPseudoType
+			return t, nil
+
+		case elem.Properties != nil || embedded:
 			// this is a standard OpenAPI object
 			atts := make(map[string]tftypes.Type, len(elem.Properties))
 			for p, v := range elem.Properties {
@@ -169,13 +186,21 @@ func getTypeFromSchema(elem *openapi3.Schema, stackdepth uint64, typeCache *sync
 				}
 				atts[p] = pType
 			}
+			if embedded {
+				// embedded resources implicitly carry type and object metadata
+				for att, at := range embeddedResourceAttributes {
+					if et, ok := atts[att]; !ok || et.Is(tftypes.DynamicPseudoType) {
+						atts[att] = at
+					}
+				}
+			}
 			t = tftypes.Object{AttributeTypes: atts}
 			if herr == nil {
 				typeCache.Store(h, t)
 			}
 			return t, nil
 
-		case elem.Properties == nil && elem.AdditionalProperties != nil:
+		case elem.AdditionalProperties != nil:
 			// this is how OpenAPI defines associative arrays
 			s, err := resolveSchemaRef(elem.AdditionalProperties, defs)
 			if err != nil {
@@ -186,13 +211,18 @@ func getTypeFromSchema(elem *openapi3.Schema, stackdepth uint64, typeCache *sync
 			if err != nil {
 				return nil, err
 			}
+			if !isTypeFullyKnown(pt) {
+				// elements may end up with different shapes, which a Map cannot hold
+				t = tftypes.DynamicPseudoType
+				return t, nil
+			}
 			t = tftypes.Map{ElementType: pt}
 			if herr == nil {
 				typeCache.Store(h, t)
 			}
 			return t, nil
 
-		case elem.Properties == nil && elem.AdditionalProperties == nil:
+		default:
 			// this is a strange case, encountered with io.k8s.apimachinery.pkg.apis.meta.v1.FieldsV1 and also io.k8s.apiextensions-apiserver.pkg.apis.apiextensions.v1.CustomResourceSubresourceStatus
 			t = tftypes.DynamicPseudoType
 			if herr == nil {
@@ -206,6 +236,40 @@ func getTypeFromSchema(elem *openapi3.Schema, stackdepth uint64, typeCache *sync
 	return nil, fmt.Errorf("unknown type: %s", elem.Type)
 }
 
+// embeddedResourceAttributes are the attributes of resources embedded in other
+// resources (x-kubernetes-embedded-resource), which schemas don't need to declare.
+// Only these fields of the metadata are retained by the API server.
+var embeddedResourceAttributes = map[string]tftypes.Type{
+	"apiVersion": tftypes.String,
+	"kind":       tftypes.String,
+	"metadata": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":         tftypes.String,
+		"namespace":    tftypes.String,
+		"generateName": tftypes.String,
+		"labels":       tftypes.Map{ElementType: tftypes.String},
+		"annotations":  tftypes.Map{ElementType: tftypes.String},
+	}},
+}
+
+// isExtensionSet checks whether a boolean vendor extension is set to true on a schema
+func isExtensionSet(elem *openapi3.Schema, name string) bool {
+	xv, ok := elem.Extensions[name]
+	if !ok {
+		return false
+	}
+	xb, ok := xv.(json.RawMessage)
+	if !ok {
+		return false
+	}
+	var x bool
+	err := json.Unmarshal(xb, &x)
+	return err == nil && x
+}
+
+func isAdditionalPropertiesAllowed(elem *openapi3.Schema) bool {
+	return elem.AdditionalPropertiesAllowed != nil && *elem.AdditionalPropertiesAllowed
+}
+
 func isTypeFullyKnown(t tftypes.Type) bool {
 	if t.Is(tftypes.DynamicPseudoType) {
 		return false
@@ -4,9 +4,12 @@
 package openapi
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestIsTypeFullyKnown(t *testing.T) {
@@ -91,3 +94,103 @@ func TestIsTypeFullyKnown(t *testing.T) {
 			})
 	}
 }
+
+func TestGetTypeFromSchemaExtensions(t *testing.T) {
+	crdSchema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"port": map[string]interface{}{
+						"x-kubernetes-int-or-string": true,
+						"anyOf": []interface{}{
+							map[string]interface{}{"type": "integer"},
+							map[string]interface{}{"type": "string"},
+						},
+					},
+					"values": map[string]interface{}{
+						"type":                                 "object",
+						"x-kubernetes-preserve-unknown-fields": true,
+						"properties": map[string]interface{}{
+							"replicas": map[string]interface{}{"type": "integer"},
+						},
+					},
+					"extra": map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": true,
+						"properties": map[string]interface{}{
+							"name": map[string]interface{}{"type": "string"},
+						},
+					},
+					"settings": map[string]interface{}{
+						"type": "object",
+						"additionalProperties": map[string]interface{}{
+							"x-kubernetes-preserve-unknown-fields": true,
+						},
+					},
+					"labels": map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": map[string]interface{}{"type": "string"},
+					},
+					"template": map[string]interface{}{
+						"type":                           "object",
+						"x-kubernetes-embedded-resource": true,
+						"properties": map[string]interface{}{
+							"metadata": map[string]interface{}{"type": "object"},
+							"spec": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"image": map[string]interface{}{"type": "string"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	j, err := json.Marshal(SchemaToSpec("", crdSchema))
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	f, err := NewFoundryFromSpecV3(j)
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	typ, hints, err := f.GetTypeByGVK(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"})
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	spec := typ.(tftypes.Object).AttributeTypes["spec"].(tftypes.Object).AttributeTypes
+
+	samples := map[string]struct {
+		t    tftypes.Type
+		hint string
+	}{
+		"port":     {tftypes.String, "io.k8s.apimachinery.pkg.util.intstr.IntOrString"},
+		"values":   {tftypes.DynamicPseudoType, manifest.PreserveUnknownFieldsLabel},
+		"extra":    {tftypes.DynamicPseudoType, ""},
+		"settings": {tftypes.DynamicPseudoType, ""},
+		"labels":   {tftypes.Map{ElementType: tftypes.String}, ""},
+		"template": {tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"apiVersion": tftypes.String,
+			"kind":       tftypes.String,
+			"metadata":   embeddedResourceAttributes["metadata"],
+			"spec": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"image": tftypes.String,
+			}},
+		}}, ""},
+	}
+	for name, s := range samples {
+		t.Run(name, func(t *testing.T) {
+			if !spec[name].Equal(s.t) {
+				t.Errorf("expected type %s, got %s", s.t, spec[name])
+			}
+			p := tftypes.NewAttributePath().WithAttributeName("spec").WithAttributeName(name)
+			if hints[p.String()] != s.hint {
+				t.Errorf("expected hint %q, got %q", s.hint, hints[p.String()])
+			}
+		})
+	}
+}
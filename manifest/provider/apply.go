@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -24,8 +25,15 @@ var defaultCreateTimeout = "10m"
 var defaultUpdateTimeout = "10m"
 var defaultDeleteTimeout = "10m"
 
+// finalizerRemovalTimeout is how long to wait for a resource to disappear
+// after its finalizers were removed because the delete timeout expired
+var finalizerRemovalTimeout = 30 * time.Second
+
 // ApplyResourceChange function
 func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	if req.TypeName == manifestsResourceType {
+		return s.applyManifestsResource(ctx, req)
+	}
 	resp := &tfprotov5.ApplyResourceChangeResponse{}
 
 	execDiag := s.canExecute()
@@ -224,6 +232,29 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 			return resp, nil
 		}
 
+		// Ignored fields are left out of the payload so they remain with their current managers
+		ignoreFields, ifDiags := getIgnoreFields(plannedStateVal)
+		if len(ifDiags) > 0 {
+			resp.Diagnostics = append(resp.Diagnostics, ifDiags...)
+			return resp, nil
+		}
+		if len(ignoreFields) > 0 {
+			obj, err = tftypes.Transform(obj, func(ap *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+				if _, ok := ignoreFields[ap.String()]; ok {
+					return tftypes.NewValue(v.Type(), nil), nil
+				}
+				return v, nil
+			})
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Failed to remove ignored fields from proposed value",
+					Detail:   err.Error(),
+				})
+				return resp, nil
+			}
+		}
+
 		nullObj := morph.UnknownToNull(obj)
 		s.logger.Trace("[ApplyResourceChange][Apply]", "[UnknownToNull]", dump(nullObj))
 
@@ -357,6 +388,30 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 			return resp, nil
 		}
 
+		if !applyPriorState.IsNull() {
+			// take over fields of the managers the resource is adopted from, typically after an import
+			adoptFrom, _, err := getFieldManagerOwnershipConfig(plannedStateVal)
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Could not extract field_manager config",
+					Detail:   err.Error(),
+				})
+				return resp, nil
+			}
+			if len(adoptFrom) > 0 {
+				err = s.adoptManagedFields(ctx, rs, rname, fieldManagerName, adoptFrom)
+				if err != nil {
+					resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  fmt.Sprintf("Failed to adopt fields of resource %q from other field managers", rnn),
+						Detail:   err.Error(),
+					})
+					return resp, nil
+				}
+			}
+		}
+
 		// figure out the timeout deadline
 		timeouts := s.getTimeouts(plannedStateVal)
 		var timeout time.Duration
@@ -432,6 +487,17 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 							Summary:  "Operation timed out",
 							Detail:   reason.Error(),
 						})
+				} else if failure, ok := err.(WaiterFailureError); ok {
+					detail := failure.Error()
+					if events := s.recentEvents(ctx, failure.Object); len(events) > 0 {
+						detail = fmt.Sprintf("%s\n\nRecent events:\n%s", detail, strings.Join(events, "\n"))
+					}
+					resp.Diagnostics = append(resp.Diagnostics,
+						&tfprotov5.Diagnostic{
+							Severity: tfprotov5.DiagnosticSeverityError,
+							Summary:  fmt.Sprintf("Resource %q reached a failure state", rnn),
+							Detail:   detail,
+						})
 				} else {
 					resp.Diagnostics = append(resp.Diagnostics,
 						&tfprotov5.Diagnostic{
@@ -538,7 +604,63 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		ctxDeadline, cancel := context.WithDeadline(ctx, deadline)
 		defer cancel()
 
-		err = rs.Delete(ctxDeadline, rname, metav1.DeleteOptions{})
+		_, releaseOnDestroy, err := getFieldManagerOwnershipConfig(priorStateVal)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Could not extract field_manager config",
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+		deletionPolicy := getDeletionPolicy(priorStateVal)
+		if releaseOnDestroy || deletionPolicy != deletionPolicyDelete {
+			// leave the resource in place, only removing it from state
+			rn := types.NamespacedName{Namespace: rnamespace, Name: rname}.String()
+			fieldManagerName, _, err := s.getFieldManagerConfig(priorStateVal)
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Could not extract field_manager config",
+					Detail:   err.Error(),
+				})
+				return resp, nil
+			}
+			// the annotation is set before releasing the fields, so that it is not left owned by our field manager
+			if deletionPolicy == deletionPolicyRetain {
+				err = s.markReleased(ctxDeadline, rs, rname, fieldManagerName)
+				if err != nil {
+					resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  fmt.Sprintf("Failed to annotate retained resource %s", rn),
+						Detail:   err.Error(),
+					})
+					return resp, nil
+				}
+			}
+			if releaseOnDestroy {
+				err = s.releaseManagedFields(ctxDeadline, rs, &uo, fieldManagerName)
+				if err != nil {
+					resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  fmt.Sprintf("Failed to release ownership of resource %s", rn),
+						Detail:   err.Error(),
+					})
+					return resp, nil
+				}
+			}
+			resp.NewState = req.PlannedState
+			return resp, nil
+		}
+
+		propagationPolicy, removeFinalizers := s.getDeleteConfig(priorStateVal)
+		deleteOptions := metav1.DeleteOptions{}
+		if propagationPolicy != "" {
+			p := metav1.DeletionPropagation(propagationPolicy)
+			deleteOptions.PropagationPolicy = &p
+		}
+
+		err = rs.Delete(ctxDeadline, rname, deleteOptions)
 		if err != nil {
 			rn := types.NamespacedName{Namespace: rnamespace, Name: rname}.String()
 			resp.Diagnostics = append(resp.Diagnostics,
@@ -553,13 +675,14 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		// wait for delete
 		for {
 			if time.Now().After(deadline) {
-				resp.Diagnostics = append(resp.Diagnostics,
-					&tfprotov5.Diagnostic{
-						Severity: tfprotov5.DiagnosticSeverityError,
-						Summary:  fmt.Sprintf("Timed out when waiting for resource %q to be deleted", rname),
-						Detail:   "Deletion timed out. This can happen when there is a finalizer on a resource. You may need to delete this resource manually with kubectl.",
-					})
-				return resp, nil
+				d := s.deleteTimedOut(ctx, rs, rname, removeFinalizers)
+				resp.Diagnostics = append(resp.Diagnostics, d...)
+				for _, dd := range d {
+					if dd.Severity == tfprotov5.DiagnosticSeverityError {
+						return resp, nil
+					}
+				}
+				break
 			}
 			_, err := rs.Get(ctxDeadline, rname, metav1.GetOptions{})
 			if err != nil {
@@ -567,6 +690,9 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 					s.logger.Trace("[ApplyResourceChange][Delete]", "Resource is deleted")
 					break
 				}
+				if ctxDeadline.Err() != nil {
+					continue
+				}
 				resp.Diagnostics = append(resp.Diagnostics,
 					&tfprotov5.Diagnostic{
 						Severity: tfprotov5.DiagnosticSeverityError,
@@ -584,6 +710,144 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 	return resp, nil
 }
 
+// deleteTimedOut is called when a resource is still present after the delete timeout has
+// expired. It reports the finalizers blocking the deletion and, if removeFinalizers is set,
+// removes them so the deletion can complete.
+func (s *RawProviderServer) deleteTimedOut(ctx context.Context, rs dynamic.ResourceInterface, rname string, removeFinalizers bool) []*tfprotov5.Diagnostic {
+	summary := fmt.Sprintf("Timed out when waiting for resource %q to be deleted", rname)
+	res, err := rs.Get(ctx, rname, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  summary,
+			Detail:   fmt.Sprintf("Deletion timed out and the resource could not be read: %v", err),
+		}}
+	}
+
+	finalizers := res.GetFinalizers()
+	if len(finalizers) == 0 {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  summary,
+			Detail:   "Deletion timed out. The resource has no finalizers, it may still be in the process of being deleted by the API server.",
+		}}
+	}
+	if !removeFinalizers {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  summary,
+			Detail: fmt.Sprintf("Deletion timed out while the following finalizers were still present on the resource: %s\n\n"+
+				"Finalizers are removed by the controllers that added them once they have finished cleaning up. "+
+				"If those controllers are no longer running, you may need to remove the finalizers manually with kubectl, "+
+				"or set \"remove_finalizers_on_timeout\" to true.", strings.Join(finalizers, ", ")),
+		}}
+	}
+
+	s.logger.Warn("[ApplyResourceChange][Delete]", "Removing finalizers", finalizers)
+	_, err = rs.Patch(ctx, rname, types.MergePatchType, []byte(`{"metadata":{"finalizers":null}}`), metav1.PatchOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  summary,
+			Detail:   fmt.Sprintf("Deletion timed out and the finalizers %s could not be removed: %v", strings.Join(finalizers, ", "), err),
+		}}
+	}
+
+	ctxDeadline, cancel := context.WithTimeout(ctx, finalizerRemovalTimeout)
+	defer cancel()
+	for {
+		_, err := rs.Get(ctxDeadline, rname, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			break
+		}
+		if ctxDeadline.Err() != nil {
+			return []*tfprotov5.Diagnostic{{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  summary,
+				Detail:   fmt.Sprintf("The finalizers %s were removed but the resource was still present after %s.", strings.Join(finalizers, ", "), finalizerRemovalTimeout),
+			}}
+		}
+		time.Sleep(1 * time.Second) // lintignore:R018
+	}
+
+	return []*tfprotov5.Diagnostic{{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  fmt.Sprintf("Removed finalizers from resource %q", rname),
+		Detail: fmt.Sprintf("The resource was not deleted before the delete timeout expired, so the following finalizers were removed: %s\n\n"+
+			"Any cleanup these finalizers were guarding has not been performed.", strings.Join(finalizers, ", ")),
+	}}
+}
+
+// getDeleteConfig returns the propagation policy and finalizer removal settings used when deleting the resource
+func (s *RawProviderServer) getDeleteConfig(v map[string]tftypes.Value) (string, bool) {
+	var propagationPolicy string
+	if dp, ok := v["delete_propagation"]; ok && !dp.IsNull() && dp.IsKnown() {
+		dp.As(&propagationPolicy)
+	}
+	var removeFinalizers bool
+	if rf, ok := v["remove_finalizers_on_timeout"]; ok && !rf.IsNull() && rf.IsKnown() {
+		rf.As(&removeFinalizers)
+	}
+	return propagationPolicy, removeFinalizers
+}
+
+// getIgnoreFields returns the paths listed in the "ignore_fields" attribute, keyed by their string form
+func getIgnoreFields(v map[string]tftypes.Value) (map[string]*tftypes.AttributePath, []*tfprotov5.Diagnostic) {
+	ignoreFields := make(map[string]*tftypes.AttributePath)
+	ifVal, ok := v["ignore_fields"]
+	if !ok || ifVal.IsNull() || !ifVal.IsKnown() {
+		return ignoreFields, nil
+	}
+	var diags []*tfprotov5.Diagnostic
+	var fields []tftypes.Value
+	ifVal.As(&fields)
+	for i, f := range fields {
+		if f.IsNull() || !f.IsKnown() {
+			continue
+		}
+		var fs string
+		f.As(&fs)
+		atp, err := FieldPathToTftypesPath(fs)
+		if err != nil {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid field path in ignore_fields",
+				Detail:    fmt.Sprintf("%q cannot be parsed: %s", fs, err),
+				Attribute: tftypes.NewAttributePath().WithAttributeName("ignore_fields").WithElementKeyInt(i),
+			})
+			continue
+		}
+		ignoreFields[atp.String()] = atp
+	}
+	return ignoreFields, diags
+}
+
+// hasPathPrefix checks whether an attribute path, or one of its parents, is one of the given paths
+func hasPathPrefix(ap *tftypes.AttributePath, paths map[string]*tftypes.AttributePath) bool {
+	if len(paths) == 0 {
+		return false
+	}
+	steps := ap.Steps()
+	for i := 1; i <= len(steps); i++ {
+		if _, ok := paths[tftypes.NewAttributePathWithSteps(steps[:i]).String()]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getDeletionPolicy returns what happens to the object when the resource is destroyed
+func getDeletionPolicy(v map[string]tftypes.Value) string {
+	policy := deletionPolicyDelete
+	if dp, ok := v["deletion_policy"]; ok && !dp.IsNull() && dp.IsKnown() {
+		dp.As(&policy)
+	}
+	return policy
+}
+
 func (s *RawProviderServer) getTimeouts(v map[string]tftypes.Value) map[string]string {
 	timeouts := map[string]string{
 		"create": defaultCreateTimeout,
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Labels and annotations of ApplySets, as defined by KEP-3659
+const (
+	applySetIDLabel              = "applyset.kubernetes.io/id"
+	applySetPartOfLabel          = "applyset.kubernetes.io/part-of"
+	applySetToolingAnnotation    = "applyset.kubernetes.io/tooling"
+	applySetGroupKindsAnnotation = "applyset.kubernetes.io/contains-group-kinds"
+	// kubectl lists the resources rather than the kinds of the members
+	applySetGroupResourcesAnnotation = "applyset.kubernetes.io/contains-group-resources"
+	applySetNamespacesAnnotation     = "applyset.kubernetes.io/additional-namespaces"
+)
+
+// applySet is the parent object of an ApplySet, which records the group kinds and
+// namespaces of its members so that any tool can find and prune them
+type applySet struct {
+	name      string
+	namespace string
+	kind      string
+	tooling   string
+}
+
+// getApplySetConfig returns the ApplySet configured by the "applyset" block, or nil
+func getApplySetConfig(v map[string]tftypes.Value, defaultTooling string) (*applySet, error) {
+	if v["applyset"].IsNull() || !v["applyset"].IsKnown() {
+		return nil, nil
+	}
+	var block []tftypes.Value
+	if err := v["applyset"].As(&block); err != nil {
+		return nil, err
+	}
+	if len(block) == 0 {
+		return nil, nil
+	}
+	var obj map[string]tftypes.Value
+	if err := block[0].As(&obj); err != nil {
+		return nil, err
+	}
+	as := &applySet{kind: "Secret", tooling: defaultTooling}
+	for k, dst := range map[string]*string{"name": &as.name, "namespace": &as.namespace, "kind": &as.kind, "tooling": &as.tooling} {
+		if obj[k].IsNull() || !obj[k].IsKnown() {
+			continue
+		}
+		if err := obj[k].As(dst); err != nil {
+			return nil, err
+		}
+	}
+	return as, nil
+}
+
+// ID returns the ApplySet ID, derived from the identity of the parent object the same way as kubectl does
+func (as *applySet) ID() string {
+	unencoded := strings.Join([]string{as.name, as.namespace, as.kind, ""}, ".")
+	hashed := sha256.Sum256([]byte(unencoded))
+	return fmt.Sprintf("applyset-%s-v1", base64.RawURLEncoding.EncodeToString(hashed[:]))
+}
+
+// resource returns the resource of the parent object, a Secret or a ConfigMap
+func (as *applySet) resource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Version: "v1", Resource: strings.ToLower(as.kind) + "s"}
+}
+
+// identity returns the objectIdentity of the parent object
+func (as *applySet) identity() string {
+	return objectIdentity(schema.GroupKind{Kind: as.kind}, as.namespace, as.name)
+}
+
+// objectIdentity identifies an object regardless of the version it is served at
+func objectIdentity(gk schema.GroupKind, namespace, name string) string {
+	return strings.Join([]string{gk.String(), namespace, name}, "/")
+}
+
+// members returns the group kinds and the namespaces, other than the namespace of the parent, of the objects
+func (as *applySet) members(objs map[string]*unstructured.Unstructured) (sets.Set[string], sets.Set[string]) {
+	gks, namespaces := sets.New[string](), sets.New[string]()
+	for _, u := range objs {
+		gks.Insert(u.GroupVersionKind().GroupKind().String())
+		if ns := u.GetNamespace(); ns != "" && ns != as.namespace {
+			namespaces.Insert(ns)
+		}
+	}
+	return gks, namespaces
+}
+
+func splitAnnotation(v string) sets.Set[string] {
+	s := sets.New[string]()
+	for _, e := range strings.Split(v, ",") {
+		if e != "" {
+			s.Insert(e)
+		}
+	}
+	return s
+}
+
+// fetchApplySetParent returns the group kinds and namespaces recorded on the parent object, if it exists
+func (s *RawProviderServer) fetchApplySetParent(ctx context.Context, as *applySet) (sets.Set[string], sets.Set[string], error) {
+	client, err := s.getDynamicClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	parent, err := client.Resource(as.resource()).Namespace(as.namespace).Get(ctx, as.name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return sets.New[string](), sets.New[string](), nil
+		}
+		return nil, nil, err
+	}
+	if id := parent.GetLabels()[applySetIDLabel]; id != "" && id != as.ID() {
+		return nil, nil, fmt.Errorf("%s %s/%s is the parent of ApplySet %q, expected %q", as.kind, as.namespace, as.name, id, as.ID())
+	}
+	annotations := parent.GetAnnotations()
+	if tooling := annotations[applySetToolingAnnotation]; tooling != "" && strings.Split(tooling, "/")[0] != strings.Split(as.tooling, "/")[0] {
+		return nil, nil, fmt.Errorf("ApplySet %s %s/%s is managed by %q", as.kind, as.namespace, as.name, tooling)
+	}
+	gks := splitAnnotation(annotations[applySetGroupKindsAnnotation])
+	if grs := splitAnnotation(annotations[applySetGroupResourcesAnnotation]); grs.Len() > 0 {
+		rm, err := s.getRestMapper()
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, gr := range sets.List(grs) {
+			gvk, err := rm.KindFor(schema.ParseGroupResource(gr).WithVersion(""))
+			if err != nil {
+				if meta.IsNoMatchError(err) {
+					continue
+				}
+				return nil, nil, err
+			}
+			gks.Insert(gvk.GroupKind().String())
+		}
+	}
+	return gks, splitAnnotation(annotations[applySetNamespacesAnnotation]), nil
+}
+
+// applyApplySetParent creates or updates the parent object with the given group kinds and namespaces
+func (s *RawProviderServer) applyApplySetParent(ctx context.Context, as *applySet, gks, namespaces sets.Set[string], fieldManager string, forceConflicts bool) error {
+	client, err := s.getDynamicClient()
+	if err != nil {
+		return err
+	}
+	parent := &unstructured.Unstructured{}
+	parent.SetAPIVersion("v1")
+	parent.SetKind(as.kind)
+	parent.SetName(as.name)
+	parent.SetNamespace(as.namespace)
+	parent.SetLabels(map[string]string{applySetIDLabel: as.ID()})
+	rm, err := s.getRestMapper()
+	if err != nil {
+		return err
+	}
+	grs := sets.New[string]()
+	for _, gk := range sets.List(gks) {
+		// kinds defined by CRDs not applied yet are recorded by the next update of the parent
+		if mapping, err := rm.RESTMapping(schema.ParseGroupKind(gk)); err == nil {
+			grs.Insert(mapping.Resource.GroupResource().String())
+		}
+	}
+	annotations := map[string]string{
+		applySetToolingAnnotation:        as.tooling,
+		applySetGroupKindsAnnotation:     strings.Join(sets.List(gks), ","),
+		applySetGroupResourcesAnnotation: strings.Join(sets.List(grs), ","),
+	}
+	if namespaces.Len() > 0 {
+		annotations[applySetNamespacesAnnotation] = strings.Join(sets.List(namespaces), ",")
+	}
+	parent.SetAnnotations(annotations)
+	body, err := parent.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	_, err = client.Resource(as.resource()).Namespace(as.namespace).Patch(ctx, as.name, types.ApplyPatchType, body, metav1.PatchOptions{
+		FieldManager: fieldManager,
+		Force:        &forceConflicts,
+	})
+	return err
+}
+
+// pruneApplySet deletes the members of the ApplySet, found by their "part-of" label in the given
+// group kinds and namespaces, which are not among the objects to keep.
+// This also removes the objects left behind by a failed apply, which are not recorded in the state.
+func (s *RawProviderServer) pruneApplySet(ctx context.Context, as *applySet, gks, namespaces sets.Set[string], keep map[string]*unstructured.Unstructured) error {
+	rm, err := s.getRestMapper()
+	if err != nil {
+		return err
+	}
+	client, err := s.getDynamicClient()
+	if err != nil {
+		return err
+	}
+	kept := sets.New[string]()
+	for _, u := range keep {
+		kept.Insert(objectIdentity(u.GroupVersionKind().GroupKind(), u.GetNamespace(), u.GetName()))
+	}
+	selector := metav1.ListOptions{LabelSelector: applySetPartOfLabel + "=" + as.ID()}
+	propagation := metav1.DeletePropagationBackground
+
+	// members are deleted in the reverse order they are applied in
+	sorted := sets.List(gks)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return manifestsKindPriority(schema.ParseGroupKind(sorted[i]).Kind) > manifestsKindPriority(schema.ParseGroupKind(sorted[j]).Kind)
+	})
+	for _, g := range sorted {
+		gk := schema.ParseGroupKind(g)
+		mapping, err := rm.RESTMapping(gk)
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return err
+		}
+		var lists []*unstructured.UnstructuredList
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			for _, ns := range append([]string{as.namespace}, sets.List(namespaces)...) {
+				l, err := client.Resource(mapping.Resource).Namespace(ns).List(ctx, selector)
+				if err != nil {
+					return err
+				}
+				lists = append(lists, l)
+			}
+		} else {
+			l, err := client.Resource(mapping.Resource).List(ctx, selector)
+			if err != nil {
+				return err
+			}
+			lists = append(lists, l)
+		}
+		for _, l := range lists {
+			for _, item := range l.Items {
+				if kept.Has(objectIdentity(gk, item.GetNamespace(), item.GetName())) {
+					continue
+				}
+				s.logger.Trace("[pruneApplySet] deleting", "kind", g, "namespace", item.GetNamespace(), "name", item.GetName())
+				rs := client.Resource(mapping.Resource).Namespace(item.GetNamespace())
+				err := rs.Delete(ctx, item.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation})
+				if err != nil && !apierrors.IsNotFound(err) {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// deleteApplySetParent deletes the parent object once all members are gone
+func (s *RawProviderServer) deleteApplySetParent(ctx context.Context, as *applySet) error {
+	client, err := s.getDynamicClient()
+	if err != nil {
+		return err
+	}
+	err = client.Resource(as.resource()).Namespace(as.namespace).Delete(ctx, as.name, metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
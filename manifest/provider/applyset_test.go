@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestApplySetID(t *testing.T) {
+	// the ID kubectl computes for the parent Secret "my-set" in namespace "test"
+	as := &applySet{name: "my-set", namespace: "test", kind: "Secret"}
+	expected := "applyset-0eFHV8ySqp7XoShsGvyWFQD3s96yqwHmzc4e0HR1dsY-v1"
+	if id := as.ID(); id != expected {
+		t.Errorf("expected %q, got %q", expected, id)
+	}
+}
+
+func TestApplySetMembers(t *testing.T) {
+	as := &applySet{name: "my-set", namespace: "test", kind: "Secret"}
+	objs := make(map[string]*unstructured.Unstructured)
+	for _, o := range []struct{ apiVersion, kind, namespace string }{
+		{"apps/v1", "Deployment", "test"},
+		{"v1", "ConfigMap", "other"},
+		{"v1", "Namespace", ""},
+	} {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(o.apiVersion)
+		u.SetKind(o.kind)
+		u.SetNamespace(o.namespace)
+		u.SetName("test")
+		objs[manifestsObjectKey(u)] = u
+	}
+	gks, namespaces := as.members(objs)
+	if !gks.Equal(sets.New("Deployment.apps", "ConfigMap", "Namespace")) {
+		t.Errorf("unexpected group kinds: %v", sets.List(gks))
+	}
+	if !namespaces.Equal(sets.New("other")) {
+		t.Errorf("unexpected namespaces: %v", sets.List(namespaces))
+	}
+}
+
+func TestGetApplySetConfig(t *testing.T) {
+	blockType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":      tftypes.String,
+		"namespace": tftypes.String,
+		"kind":      tftypes.String,
+		"tooling":   tftypes.String,
+	}}
+	v := map[string]tftypes.Value{
+		"applyset": tftypes.NewValue(tftypes.List{ElementType: blockType}, []tftypes.Value{
+			tftypes.NewValue(blockType, map[string]tftypes.Value{
+				"name":      tftypes.NewValue(tftypes.String, "my-set"),
+				"namespace": tftypes.NewValue(tftypes.String, "test"),
+				"kind":      tftypes.NewValue(tftypes.String, nil),
+				"tooling":   tftypes.NewValue(tftypes.String, nil),
+			}),
+		}),
+	}
+	as, err := getApplySetConfig(v, "Terraform/v1.9.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := applySet{name: "my-set", namespace: "test", kind: "Secret", tooling: "Terraform/v1.9.0"}
+	if as == nil || *as != expected {
+		t.Errorf("expected %v, got %v", expected, as)
+	}
+
+	as, err = getApplySetConfig(map[string]tftypes.Value{}, "Terraform/v1.9.0")
+	if err != nil || as != nil {
+		t.Errorf("expected no ApplySet, got %v, %v", as, err)
+	}
+}
+
+func TestSplitAnnotation(t *testing.T) {
+	if s := splitAnnotation(""); s.Len() != 0 {
+		t.Errorf("expected an empty set, got %v", sets.List(s))
+	}
+	if s := splitAnnotation("secrets,deployments.apps"); !s.Equal(sets.New("secrets", "deployments.apps")) {
+		t.Errorf("unexpected set: %v", sets.List(s))
+	}
+}
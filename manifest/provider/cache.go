@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/discovery/cached/disk"
+)
+
+// discoveryCacheTTL is how long cached discovery data is used before being
+// refreshed, the same as for kubectl
+const discoveryCacheTTL = 6 * time.Hour
+
+// clusterUIDFile is stored alongside the discovery cache to detect when a
+// different cluster has taken over the same server URL
+const clusterUIDFile = "cluster-uid"
+
+// overlyCautiousIllegalFileCharacters matches characters that *might* not be supported. Same as kubectl.
+var overlyCautiousIllegalFileCharacters = regexp.MustCompile(`[^(\w/.)]`)
+
+// computeDiscoveryCacheDir returns the discovery cache directory of a server
+// using the same naming scheme as kubectl
+func computeDiscoveryCacheDir(parentDir, host string) string {
+	schemelessHost := strings.Replace(strings.Replace(host, "https://", "", 1), "http://", "", 1)
+	safeHost := overlyCautiousIllegalFileCharacters.ReplaceAllString(schemelessHost, "_")
+	return filepath.Join(parentDir, safeHost)
+}
+
+// getCachedDiscoveryClient returns a discovery client which persists discovery data and OpenAPI
+// documents in the cache directory, laid out the same as kubectl's "~/.kube/cache". Discovery data
+// is refreshed after discoveryCacheTTL or when the cluster behind the server URL changes, while
+// OpenAPI documents are revalidated against their ETag.
+func (ps *RawProviderServer) getCachedDiscoveryClient() (*disk.CachedDiscoveryClient, error) {
+	httpCacheDir := filepath.Join(ps.cacheDir, "http")
+	discoveryCacheDir := computeDiscoveryCacheDir(filepath.Join(ps.cacheDir, "discovery"), ps.clientConfig.Host)
+
+	dc, err := disk.NewCachedDiscoveryClientForConfig(ps.clientConfig, discoveryCacheDir, httpCacheDir, discoveryCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := ps.getClusterUID()
+	if err != nil {
+		ps.logger.Debug("[getCachedDiscoveryClient] cannot determine cluster UID, skipping cache identity check", "error", err.Error())
+		return dc, nil
+	}
+	uidPath := filepath.Join(discoveryCacheDir, clusterUIDFile)
+	cachedUID, err := os.ReadFile(uidPath)
+	if err == nil && bytes.Equal(bytes.TrimSpace(cachedUID), []byte(uid)) {
+		return dc, nil
+	}
+	if err == nil {
+		ps.logger.Debug("[getCachedDiscoveryClient] cluster UID changed, invalidating discovery cache", "cached", string(cachedUID), "current", uid)
+		dc.Invalidate()
+	}
+	if err := os.MkdirAll(discoveryCacheDir, 0o750); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(uidPath, []byte(uid), 0o660); err != nil {
+		return nil, err
+	}
+	return dc, nil
+}
+
+// getClusterUID identifies the cluster by the UID of its "kube-system" namespace
+func (ps *RawProviderServer) getClusterUID() (string, error) {
+	rc, err := ps.getRestClient()
+	if err != nil {
+		return "", err
+	}
+	rs, err := rc.Get().AbsPath("api", "v1", "namespaces", "kube-system").Timeout(10 * time.Second).DoRaw(context.TODO())
+	if err != nil {
+		return "", err
+	}
+	var ns struct {
+		Metadata struct {
+			UID string `json:"uid"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(rs, &ns); err != nil {
+		return "", err
+	}
+	if ns.Metadata.UID == "" {
+		return "", errors.New("namespace has no UID")
+	}
+	return ns.Metadata.UID, nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+func TestComputeDiscoveryCacheDir(t *testing.T) {
+	samples := map[string]string{
+		"https://127.0.0.1:6443":              "127.0.0.1_6443",
+		"http://localhost:8080":               "localhost_8080",
+		"https://example.com/k8s/clusters/c1": "example.com/k8s/clusters/c1",
+	}
+	for host, dir := range samples {
+		if d := computeDiscoveryCacheDir("/cache", host); d != filepath.Join("/cache", dir) {
+			t.Errorf("unexpected cache dir for %q: %s", host, d)
+		}
+	}
+}
+
+func newTestDiscoveryServer(uid *atomic.Value, hits *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api":
+			atomic.AddInt32(hits, 1)
+			fmt.Fprint(w, `{"kind":"APIVersions","versions":["v1"]}`)
+		case "/apis":
+			fmt.Fprint(w, `{"kind":"APIGroupList","groups":[]}`)
+		case "/api/v1":
+			fmt.Fprint(w, `{"kind":"APIResourceList","groupVersion":"v1","resources":[{"name":"configmaps","singularName":"configmap","namespaced":true,"kind":"ConfigMap","verbs":["get"]}]}`)
+		case "/api/v1/namespaces/kube-system":
+			fmt.Fprintf(w, `{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"kube-system","uid":%q}}`, uid.Load().(string))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newTestCachedServer(host, cacheDir string) *RawProviderServer {
+	cfg := &rest.Config{Host: host}
+	codec := runtime.NoopEncoder{Decoder: scheme.Codecs.UniversalDecoder()}
+	cfg.NegotiatedSerializer = serializer.NegotiatedSerializerWrapper(runtime.SerializerInfo{Serializer: codec})
+	return &RawProviderServer{logger: hclog.NewNullLogger(), clientConfig: cfg, cacheDir: cacheDir}
+}
+
+func TestCachedDiscovery(t *testing.T) {
+	var uid atomic.Value
+	var hits int32
+	uid.Store("cluster-1")
+	srv := newTestDiscoveryServer(&uid, &hits)
+	defer srv.Close()
+
+	cacheDir := t.TempDir()
+	discoveryDir := computeDiscoveryCacheDir(filepath.Join(cacheDir, "discovery"), srv.URL)
+	gk := schema.GroupKind{Kind: "ConfigMap"}
+
+	lookup := func() {
+		t.Helper()
+		rm, err := newTestCachedServer(srv.URL, cacheDir).getRestMapper()
+		if err != nil {
+			t.Fatalf("failed to create RESTMapper: %s", err)
+		}
+		m, err := rm.RESTMapping(gk, "v1")
+		if err != nil {
+			t.Fatalf("failed to map ConfigMap: %s", err)
+		}
+		if m.Resource.Resource != "configmaps" {
+			t.Fatalf("unexpected resource: %s", m.Resource)
+		}
+	}
+
+	lookup()
+	if _, err := os.Stat(filepath.Join(discoveryDir, "servergroups.json")); err != nil {
+		t.Fatalf("discovery data was not cached: %s", err)
+	}
+	if u, _ := os.ReadFile(filepath.Join(discoveryDir, clusterUIDFile)); string(u) != "cluster-1" {
+		t.Fatalf("unexpected cluster UID in cache: %q", u)
+	}
+	if hits != 1 {
+		t.Fatalf("expected 1 discovery request, got %d", hits)
+	}
+
+	// a new provider instance is served from the cache
+	lookup()
+	if hits != 1 {
+		t.Errorf("expected discovery to be served from cache, got %d requests", hits)
+	}
+
+	// a different cluster behind the same URL invalidates the cache
+	uid.Store("cluster-2")
+	lookup()
+	if hits != 2 {
+		t.Errorf("expected discovery to be refreshed, got %d requests", hits)
+	}
+	if u, _ := os.ReadFile(filepath.Join(discoveryDir, clusterUIDFile)); string(u) != "cluster-2" {
+		t.Errorf("unexpected cluster UID in cache: %q", u)
+	}
+}
+
+func TestCachedOpenAPIv2(t *testing.T) {
+	var uid atomic.Value
+	var hits, downloads int32
+	uid.Store("cluster-1")
+	discovery := newTestDiscoveryServer(&uid, &hits)
+	defer discovery.Close()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/openapi/v2" {
+			discovery.Config.Handler.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("ETag", `"v2-spec"`)
+		if r.Header.Get("If-None-Match") == `"v2-spec"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		atomic.AddInt32(&downloads, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"swagger":"2.0","info":{"title":"Kubernetes","version":"v1"},"paths":{},"definitions":{"io.k8s.api.core.v1.ConfigMap":{"type":"object"}}}`)
+	}))
+	defer srv.Close()
+
+	cacheDir := t.TempDir()
+	for i := 0; i < 2; i++ {
+		if _, err := newTestCachedServer(srv.URL, cacheDir).getOAPIv2Foundry(); err != nil {
+			t.Fatalf("failed to create OpenAPI v2 foundry: %s", err)
+		}
+	}
+	if downloads != 1 {
+		t.Errorf("expected the OpenAPI v2 spec to be downloaded once, got %d downloads", downloads)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -15,8 +16,10 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/disk"
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 
@@ -45,6 +48,22 @@ func (ps *RawProviderServer) getDynamicClient() (dynamic.Interface, error) {
 	return dynClient, nil
 }
 
+// getMetadataClient returns a configured client for the PartialObjectMetadata of resources
+func (ps *RawProviderServer) getMetadataClient() (metadata.Interface, error) {
+	if ps.metadataClient != nil {
+		return ps.metadataClient, nil
+	}
+	if ps.clientConfig == nil {
+		return nil, fmt.Errorf("cannot create metadata client: no client config")
+	}
+	metaClient, err := metadata.NewForConfig(ps.clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	ps.metadataClient = metaClient
+	return metaClient, nil
+}
+
 // getDiscoveryClient returns a configured discovery client instance.
 func (ps *RawProviderServer) getDiscoveryClient() (discovery.DiscoveryInterface, error) {
 	if ps.discoveryClient != nil {
@@ -53,6 +72,14 @@ func (ps *RawProviderServer) getDiscoveryClient() (discovery.DiscoveryInterface,
 	if ps.clientConfig == nil {
 		return nil, fmt.Errorf("cannot create discovery client: no client config")
 	}
+	if ps.cacheDir != "" {
+		cachedClient, err := ps.getCachedDiscoveryClient()
+		if err != nil {
+			return nil, fmt.Errorf("cannot create cached discovery client: %s", err)
+		}
+		ps.discoveryClient = cachedClient
+		return cachedClient, nil
+	}
 	discoClient, err := discovery.NewDiscoveryClientForConfig(ps.clientConfig)
 	if err != nil {
 		return nil, err
@@ -68,6 +95,10 @@ func (ps *RawProviderServer) getRestMapper() (meta.RESTMapper, error) {
 	}
 	dc, err := ps.getDiscoveryClient()
 	if err != nil {
+		if ps.localSchemas != nil {
+			// no API server configured yet, resolve mappings from the schema sources alone
+			return &localFirstRESTMapper{local: ps.localSchemas.mapper}, nil
+		}
 		return nil, err
 	}
 
@@ -77,8 +108,17 @@ func (ps *RawProviderServer) getRestMapper() (meta.RESTMapper, error) {
 	// }
 	// mapper := restmapper.NewDeferredDiscoveryRESTMapper(agr)
 
-	cache := memory.NewMemCacheClient(dc)
+	cache, ok := dc.(discovery.CachedDiscoveryInterface)
+	if !ok {
+		cache = memory.NewMemCacheClient(dc)
+	}
 	ps.restMapper = restmapper.NewDeferredDiscoveryRESTMapper(cache)
+	if ps.localSchemas != nil {
+		ps.restMapper = &localFirstRESTMapper{
+			local:  ps.localSchemas.mapper,
+			remote: ps.restMapper,
+		}
+	}
 	return ps.restMapper, nil
 }
 
@@ -98,13 +138,33 @@ func (ps *RawProviderServer) getRestClient() (rest.Interface, error) {
 	return restClient, nil
 }
 
-// getOAPIv2Foundry returns an interface to request tftype types from an OpenAPIv2 spec
-func (ps *RawProviderServer) getOAPIv2Foundry() (openapi.Foundry, error) {
+// getOAPIFoundry returns an interface to request tftype types from the cluster's OpenAPI specs.
+// The per group-version OpenAPI v3 documents are preferred, falling back to the OpenAPI v2 spec
+// on clusters which do not serve /openapi/v3.
+func (ps *RawProviderServer) getOAPIFoundry() (openapi.Foundry, error) {
 	if ps.OAPIFoundry != nil {
 		return ps.OAPIFoundry, nil
 	}
 
-	rc, err := ps.getRestClient()
+	dc, err := ps.getDiscoveryClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed get OpenAPI spec: %s", err)
+	}
+
+	oapif, err := openapi.NewFoundryFromOpenAPIV3(dc.OpenAPIV3())
+	if err != nil {
+		ps.logger.Debug("[getOAPIFoundry] OpenAPI v3 is not available, falling back to v2", "error", err.Error())
+		return ps.getOAPIv2Foundry()
+	}
+
+	ps.OAPIFoundry = oapif
+
+	return oapif, nil
+}
+
+// getOAPIv2Foundry returns an interface to request tftype types from an OpenAPIv2 spec
+func (ps *RawProviderServer) getOAPIv2Foundry() (openapi.Foundry, error) {
+	rc, err := ps.getOAPIv2RestClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed get OpenAPI spec: %s", err)
 	}
@@ -125,6 +185,22 @@ func (ps *RawProviderServer) getOAPIv2Foundry() (openapi.Foundry, error) {
 	return oapif, nil
 }
 
+// getOAPIv2RestClient returns the REST client to download the OpenAPI v2 spec with. When the cache
+// is enabled, this is the REST client of the cached discovery client, whose HTTP cache keeps the spec
+// on disk and only downloads it again when the API server reports a different ETag.
+func (ps *RawProviderServer) getOAPIv2RestClient() (rest.Interface, error) {
+	if ps.cacheDir != "" {
+		dc, err := ps.getDiscoveryClient()
+		if err != nil {
+			return nil, err
+		}
+		if cdc, ok := dc.(*disk.CachedDiscoveryClient); ok {
+			return cdc.RESTClient(), nil
+		}
+	}
+	return ps.getRestClient()
+}
+
 func loggingTransport(rt http.RoundTripper) http.RoundTripper {
 	return &loggingRountTripper{
 		ot: rt,
@@ -138,8 +214,8 @@ type loggingRountTripper struct {
 }
 
 func (t *loggingRountTripper) RoundTrip(req *http.Request) (*http.Response, error) {
-	if req.URL.Path == "/openapi/v2" {
-		// don't trace-log the OpenAPI spec document, it's really big
+	if req.URL.Path == "/openapi/v2" || strings.HasPrefix(req.URL.Path, "/openapi/v3/") {
+		// don't trace-log the OpenAPI spec documents, they're really big
 		return t.ot.RoundTrip(req)
 	}
 	return t.lt.RoundTrip(req)
@@ -333,6 +333,69 @@ func (s *RawProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov
 		overrides.AuthInfo.ClientKeyData = []byte(clientKey)
 	}
 
+	// Handle 'cache_dir' attribute
+	//
+	var cacheDir string
+	if !providerConfig["cache_dir"].IsNull() && providerConfig["cache_dir"].IsKnown() {
+		err = providerConfig["cache_dir"].As(&cacheDir)
+		if err != nil {
+			// invalid attribute type - this shouldn't happen, bail out for now
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'cache_dir' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+	}
+	if cacheDirEnv, ok := os.LookupEnv("KUBE_CACHE_DIR"); ok && cacheDirEnv != "" {
+		cacheDir = cacheDirEnv
+	}
+	if len(cacheDir) > 0 {
+		cacheDirAbs, err := homedir.Expand(cacheDir)
+		if err != nil {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityInvalid,
+				Summary:  "Invalid attribute in provider configuration",
+				Detail:   fmt.Sprintf("'cache_dir' refers to an invalid path: %q: %v", cacheDir, err),
+			})
+		}
+		s.cacheDir = cacheDirAbs
+	}
+
+	// Handle 'schema_sources' attribute
+	//
+	if !providerConfig["schema_sources"].IsNull() && providerConfig["schema_sources"].IsFullyKnown() {
+		var sourcesVal []tftypes.Value
+		err = providerConfig["schema_sources"].As(&sourcesVal)
+		if err != nil {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to extract 'schema_sources' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+		sources := make([]string, 0, len(sourcesVal))
+		for _, v := range sourcesVal {
+			var src string
+			v.As(&src)
+			sources = append(sources, src)
+		}
+		if len(sources) > 0 {
+			ls, err := loadSchemaSources(sources)
+			if err != nil {
+				diags = append(diags, &tfprotov5.Diagnostic{
+					Severity:  tfprotov5.DiagnosticSeverityInvalid,
+					Summary:   "Invalid attribute in provider configuration",
+					Detail:    fmt.Sprintf("'schema_sources' could not be loaded: %v", err),
+					Attribute: tftypes.NewAttributePath().WithAttributeName("schema_sources"),
+				})
+			}
+			s.localSchemas = ls
+		}
+	}
+
 	if len(diags) > 0 {
 		response.Diagnostics = diags
 		return response, nil
@@ -17,7 +17,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/metadata"
 )
 
 func (s *RawProviderServer) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
@@ -26,6 +29,8 @@ func (s *RawProviderServer) ReadDataSource(ctx context.Context, req *tfprotov5.R
 		return s.ReadSingularDataSource(ctx, req)
 	case "kubernetes_resources":
 		return s.ReadPluralDataSource(ctx, req)
+	case "kubernetes_resource_table":
+		return s.ReadTableDataSource(ctx, req)
 	}
 
 	resp := &tfprotov5.ReadDataSourceResponse{}
@@ -36,6 +41,117 @@ func (s *RawProviderServer) ReadDataSource(ctx context.Context, req *tfprotov5.R
 	return resp, nil
 }
 
+// validatePluralDataSource checks the combinations of list options of the "kubernetes_resources"
+// and "kubernetes_resource_table" data sources
+func (s *RawProviderServer) validatePluralDataSource(ctx context.Context, req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	resp := &tfprotov5.ValidateDataSourceConfigResponse{}
+
+	rt, err := GetDataSourceType(req.TypeName)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to determine data source type",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	config, err := req.Config.Unmarshal(rt)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to unmarshal data source configuration",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	var dsConfig map[string]tftypes.Value
+	if err := config.As(&dsConfig); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to extract attributes from data source configuration",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	var allNamespaces bool
+	if v := dsConfig["all_namespaces"]; v.IsKnown() && !v.IsNull() {
+		v.As(&allNamespaces)
+	}
+	if allNamespaces && !dsConfig["namespace"].IsNull() {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Conflicting list options",
+			Detail:    `"namespace" cannot be set when "all_namespaces" is true.`,
+			Attribute: tftypes.NewAttributePath().WithAttributeName("namespace"),
+		})
+	}
+
+	for _, k := range []string{"limit", "page_size"} {
+		v := dsConfig[k]
+		if !v.IsKnown() || v.IsNull() {
+			continue
+		}
+		var n big.Float
+		v.As(&n)
+		if n.Sign() < 0 || !n.IsInt() {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid list option",
+				Detail:    fmt.Sprintf("%q must be a non-negative whole number.", k),
+				Attribute: tftypes.NewAttributePath().WithAttributeName(k),
+			})
+		}
+	}
+
+	if v := dsConfig["filter"]; v.IsKnown() && !v.IsNull() {
+		var filter string
+		v.As(&filter)
+		if _, err := CompileWaitExpression(filter); err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid filter expression",
+				Detail:    err.Error(),
+				Attribute: tftypes.NewAttributePath().WithAttributeName("filter"),
+			})
+		}
+	}
+	if selectExprs, err := getSelectConfig(dsConfig); err == nil {
+		if _, err := compileProjections(selectExprs); err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid select expression",
+				Detail:    err.Error(),
+				Attribute: tftypes.NewAttributePath().WithAttributeName("select"),
+			})
+		}
+	}
+
+	if v := dsConfig["resource_version_match"]; v.IsKnown() && !v.IsNull() {
+		var match string
+		v.As(&match)
+		switch metav1.ResourceVersionMatch(match) {
+		case metav1.ResourceVersionMatchExact, metav1.ResourceVersionMatchNotOlderThan:
+		default:
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid list option",
+				Detail:    fmt.Sprintf(`"resource_version_match" must be one of "Exact" or "NotOlderThan", got %q.`, match),
+				Attribute: tftypes.NewAttributePath().WithAttributeName("resource_version_match"),
+			})
+		}
+		if dsConfig["resource_version"].IsNull() {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid list option",
+				Detail:    `"resource_version_match" requires "resource_version" to be set.`,
+				Attribute: tftypes.NewAttributePath().WithAttributeName("resource_version_match"),
+			})
+		}
+	}
+	return resp, nil
+}
+
 func (s *RawProviderServer) ReadPluralDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
 
 	s.logger.Trace("[ReadDataSource][Request]\n%s\n", dump(*req))
@@ -136,30 +252,63 @@ func (s *RawProviderServer) ReadPluralDataSource(ctx context.Context, req *tfpro
 		return resp, nil
 	}
 
-	var labelSelector, fieldSelector string
+	var labelSelector, fieldSelector, resourceVersion, resourceVersionMatch string
 	dsConfig["label_selector"].As(&labelSelector)
 	dsConfig["field_selector"].As(&fieldSelector)
-	var limit big.Float
+	dsConfig["resource_version"].As(&resourceVersion)
+	dsConfig["resource_version_match"].As(&resourceVersionMatch)
+	var limit, pageSize big.Float
 	dsConfig["limit"].As(&limit)
+	dsConfig["page_size"].As(&pageSize)
 	lim, _ := limit.Int64()
+	size, _ := pageSize.Int64()
+	var allNamespaces, metadataOnly bool
+	dsConfig["all_namespaces"].As(&allNamespaces)
+	dsConfig["metadata_only"].As(&metadataOnly)
+	var filter string
+	dsConfig["filter"].As(&filter)
+	selectExprs, err := getSelectConfig(dsConfig)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to extract attributes from data source configuration",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
 	listOptions := metav1.ListOptions{
-		LabelSelector: labelSelector,
-		FieldSelector: fieldSelector,
-		Limit:         lim,
+		LabelSelector:        labelSelector,
+		FieldSelector:        fieldSelector,
+		ResourceVersion:      resourceVersion,
+		ResourceVersionMatch: metav1.ResourceVersionMatch(resourceVersionMatch),
 	}
 
-	var res *unstructured.UnstructuredList
-
-	if ns {
-		var namespace string
+	var namespace string
+	if ns && !allNamespaces {
 		dsConfig["namespace"].As(&namespace)
 		if namespace == "" {
 			namespace = "default"
 		}
-		res, err = rcl.Namespace(namespace).List(ctx, listOptions)
+	}
+
+	var list listPageFunc
+	if metadataOnly {
+		mc, err := s.getMetadataClient()
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to get metadata client",
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+		objectType = metadataObjectType(objectType)
+		list = listMetadataPage(mc.Resource(gvr).Namespace(namespace), gvk)
 	} else {
-		res, err = rcl.List(ctx, listOptions)
+		list = listPage(rcl.Namespace(namespace))
 	}
+
+	items, listVersion, err := listAllPages(ctx, list, listOptions, lim, size)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			return resp, nil
@@ -173,9 +322,66 @@ func (s *RawProviderServer) ReadPluralDataSource(ctx context.Context, req *tfpro
 		return resp, nil
 	}
 
+	items, err = filterObjects(items, filter)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Failed to evaluate filter expression",
+			Detail:    err.Error(),
+			Attribute: tftypes.NewAttributePath().WithAttributeName("filter"),
+		})
+		return resp, nil
+	}
+
+	rawState := make(map[string]tftypes.Value)
+	err = config.As(&rawState)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to save resource state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	if resourceVersion == "" {
+		rawState["resource_version"] = tftypes.NewValue(tftypes.String, listVersion)
+	}
+
+	if len(selectExprs) > 0 {
+		projections, err := compileProjections(selectExprs)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid select expression",
+				Detail:    err.Error(),
+				Attribute: tftypes.NewAttributePath().WithAttributeName("select"),
+			})
+			return resp, nil
+		}
+		projected := make([]tftypes.Value, len(items))
+		elementTypes := make([]tftypes.Type, len(items))
+		for i, item := range items {
+			projected[i], err = projectObject(item, projections, tftypes.NewAttributePath().WithElementKeyInt(i))
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "Failed to evaluate select expression",
+					Detail:    err.Error(),
+					Attribute: tftypes.NewAttributePath().WithAttributeName("select"),
+				})
+				return resp, nil
+			}
+			elementTypes[i] = projected[i].Type()
+		}
+		// the objects are left out of the state when only the selected values are needed
+		rawState["objects"] = tftypes.NewValue(tftypes.DynamicPseudoType, nil)
+		rawState["items"] = tftypes.NewValue(tftypes.Tuple{ElementTypes: elementTypes}, projected)
+		return s.pluralDataSourceState(resp, rt, rawState)
+	}
+
 	listObjects := []tftypes.Value{}
-	for _, item := range res.Items {
-		nobj, err := payload.ToTFValue(item.Object, objectType, th, tftypes.NewAttributePath())
+	for _, item := range items {
+		nobj, err := payload.ToTFValue(item, objectType, th, tftypes.NewAttributePath())
 		if err != nil {
 			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
 				Severity: tfprotov5.DiagnosticSeverityError,
@@ -205,18 +411,13 @@ func (s *RawProviderServer) ReadPluralDataSource(ctx context.Context, req *tfpro
 	tupleType := tftypes.Tuple{ElementTypes: elementTypes}
 	tuple := tftypes.NewValue(tupleType, listObjects)
 
-	rawState := make(map[string]tftypes.Value)
-	err = config.As(&rawState)
-	if err != nil {
-		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
-			Severity: tfprotov5.DiagnosticSeverityError,
-			Summary:  "Failed to save resource state",
-			Detail:   err.Error(),
-		})
-		return resp, nil
-	}
 	rawState["objects"] = morph.UnknownToNull(tuple)
+	rawState["items"] = tftypes.NewValue(tftypes.DynamicPseudoType, nil)
+	return s.pluralDataSourceState(resp, rt, rawState)
+}
 
+// pluralDataSourceState sets the state of the "kubernetes_resources" data source on the response
+func (s *RawProviderServer) pluralDataSourceState(resp *tfprotov5.ReadDataSourceResponse, rt tftypes.Type, rawState map[string]tftypes.Value) (*tfprotov5.ReadDataSourceResponse, error) {
 	v := tftypes.NewValue(rt, rawState)
 	state, err := tfprotov5.NewDynamicValue(v.Type(), v)
 	if err != nil {
@@ -420,3 +621,145 @@ func getGVR(apiVersion, kind string, m meta.RESTMapper) (schema.GroupVersionReso
 	}
 	return mapping.Resource, err
 }
+
+// getSelectConfig returns the known expressions of the "select" attribute
+func getSelectConfig(v map[string]tftypes.Value) (map[string]string, error) {
+	exprs := map[string]string{}
+	if v["select"].IsNull() || !v["select"].IsKnown() {
+		return exprs, nil
+	}
+	var m map[string]tftypes.Value
+	if err := v["select"].As(&m); err != nil {
+		return nil, err
+	}
+	for name, e := range m {
+		if e.IsNull() || !e.IsKnown() {
+			continue
+		}
+		var expr string
+		if err := e.As(&expr); err != nil {
+			return nil, err
+		}
+		exprs[name] = expr
+	}
+	return exprs, nil
+}
+
+// filterObjects returns the objects for which the CEL expression is true
+func filterObjects(items []map[string]interface{}, expr string) ([]map[string]interface{}, error) {
+	if expr == "" {
+		return items, nil
+	}
+	prg, err := CompileWaitExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		ok, err := evalWaitExpression(prg, item)
+		if err != nil {
+			u := unstructured.Unstructured{Object: item}
+			name := u.GetName()
+			if u.GetNamespace() != "" {
+				name = u.GetNamespace() + "/" + name
+			}
+			return nil, fmt.Errorf("%s: %s. Use has() to test for fields not set on every object", name, err)
+		}
+		if ok {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+// defaultPageSize is the number of objects requested at a time when listing resources, as kubectl does
+const defaultPageSize = 500
+
+// listPageFunc requests one page of a list call and returns its objects and list metadata
+type listPageFunc func(ctx context.Context, opts metav1.ListOptions) ([]map[string]interface{}, metav1.ListMeta, error)
+
+// listPage lists the objects of a resource with the dynamic client
+func listPage(rs dynamic.ResourceInterface) listPageFunc {
+	return func(ctx context.Context, opts metav1.ListOptions) ([]map[string]interface{}, metav1.ListMeta, error) {
+		res, err := rs.List(ctx, opts)
+		if err != nil {
+			return nil, metav1.ListMeta{}, err
+		}
+		items := make([]map[string]interface{}, len(res.Items))
+		for i := range res.Items {
+			items[i] = res.Items[i].Object
+		}
+		return items, metav1.ListMeta{ResourceVersion: res.GetResourceVersion(), Continue: res.GetContinue()}, nil
+	}
+}
+
+// listMetadataPage lists the PartialObjectMetadata of the objects of a resource,
+// and sets their apiVersion and kind to the ones of the resource
+func listMetadataPage(rs metadata.ResourceInterface, gvk schema.GroupVersionKind) listPageFunc {
+	return func(ctx context.Context, opts metav1.ListOptions) ([]map[string]interface{}, metav1.ListMeta, error) {
+		res, err := rs.List(ctx, opts)
+		if err != nil {
+			return nil, metav1.ListMeta{}, err
+		}
+		items := make([]map[string]interface{}, len(res.Items))
+		for i := range res.Items {
+			res.Items[i].SetGroupVersionKind(gvk)
+			items[i], err = runtime.DefaultUnstructuredConverter.ToUnstructured(&res.Items[i])
+			if err != nil {
+				return nil, metav1.ListMeta{}, err
+			}
+		}
+		return items, res.ListMeta, nil
+	}
+}
+
+// listAllPages follows the continue tokens of a list call until all objects, or limit objects
+// when limit is positive, are read. It returns the objects and the resourceVersion of the list.
+func listAllPages(ctx context.Context, list listPageFunc, opts metav1.ListOptions, limit, pageSize int64) ([]map[string]interface{}, string, error) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	var items []map[string]interface{}
+	var resourceVersion string
+	for {
+		opts.Limit = pageSize
+		if limit > 0 && limit-int64(len(items)) < pageSize {
+			opts.Limit = limit - int64(len(items))
+		}
+		page, lm, err := list(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		items = append(items, page...)
+		if resourceVersion == "" {
+			// all pages are served at the resourceVersion of the first one
+			resourceVersion = lm.ResourceVersion
+		}
+		if lm.Continue == "" || (limit > 0 && int64(len(items)) >= limit) {
+			break
+		}
+		// the resourceVersion is carried by the continue token
+		opts.Continue = lm.Continue
+		opts.ResourceVersion = ""
+		opts.ResourceVersionMatch = ""
+	}
+	if limit > 0 && int64(len(items)) > limit {
+		items = items[:limit]
+	}
+	return items, resourceVersion, nil
+}
+
+// metadataObjectType restricts the type of a resource to the fields returned as PartialObjectMetadata
+func metadataObjectType(t tftypes.Type) tftypes.Type {
+	ot, ok := t.(tftypes.Object)
+	if !ok {
+		return t
+	}
+	mt := tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}
+	for _, k := range []string{"apiVersion", "kind", "metadata"} {
+		if at, ok := ot.AttributeTypes[k]; ok {
+			mt.AttributeTypes[k] = at
+		}
+	}
+	return mt
+}
@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+)
+
+// tableAcceptHeader requests the list of a resource in the Table format printed by "kubectl get"
+const tableAcceptHeader = "application/json;as=Table;g=meta.k8s.io;v=v1,application/json"
+
+var tableColumnType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"name":        tftypes.String,
+	"type":        tftypes.String,
+	"format":      tftypes.String,
+	"description": tftypes.String,
+	"priority":    tftypes.Number,
+}}
+
+var tableRowType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"name":      tftypes.String,
+	"namespace": tftypes.String,
+	"cells":     tftypes.Map{ElementType: tftypes.String},
+}}
+
+// ReadTableDataSource lists a resource through the Table API of the API server
+func (s *RawProviderServer) ReadTableDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	s.logger.Trace("[ReadDataSource][Request]\n%s\n", dump(*req))
+
+	resp := &tfprotov5.ReadDataSourceResponse{}
+
+	execDiag := s.canExecute()
+	if len(execDiag) > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, execDiag...)
+		return resp, nil
+	}
+
+	rt, err := GetDataSourceType(req.TypeName)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to determine data source type",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	config, err := req.Config.Unmarshal(rt)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to unmarshal data source configuration",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	var dsConfig map[string]tftypes.Value
+	err = config.As(&dsConfig)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to extract attributes from data source configuration",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	rm, err := s.getRestMapper()
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to get RESTMapper client",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	rc, err := s.getRestClient()
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to get REST client",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	var apiVersion, kind string
+	dsConfig["api_version"].As(&apiVersion)
+	dsConfig["kind"].As(&kind)
+
+	gvr, err := getGVR(apiVersion, kind, rm)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to determine resource GroupVersion",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	ns, err := IsResourceNamespaced(gvr.GroupVersion().WithKind(kind), rm)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed determine if resource is namespaced",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	var labelSelector, fieldSelector string
+	dsConfig["label_selector"].As(&labelSelector)
+	dsConfig["field_selector"].As(&fieldSelector)
+	var limit, pageSize big.Float
+	dsConfig["limit"].As(&limit)
+	dsConfig["page_size"].As(&pageSize)
+	lim, _ := limit.Int64()
+	size, _ := pageSize.Int64()
+	var allNamespaces, wide bool
+	dsConfig["all_namespaces"].As(&allNamespaces)
+	dsConfig["wide"].As(&wide)
+
+	var namespace string
+	if ns && !allNamespaces {
+		dsConfig["namespace"].As(&namespace)
+		if namespace == "" {
+			namespace = "default"
+		}
+	}
+
+	listOptions := metav1.ListOptions{
+		LabelSelector: labelSelector,
+		FieldSelector: fieldSelector,
+	}
+	var columns []metav1.TableColumnDefinition
+	rows, _, err := listAllPages(ctx, listTablePage(rc, tableResourcePath(gvr, namespace), &columns), listOptions, lim, size)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return resp, nil
+		}
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to get data source",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	rawState := make(map[string]tftypes.Value)
+	err = config.As(&rawState)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to save resource state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	rawState["columns"], rawState["rows"] = tableToValues(columns, rows, wide)
+
+	v := tftypes.NewValue(rt, rawState)
+	state, err := tfprotov5.NewDynamicValue(v.Type(), v)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to save resource state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	resp.State = &state
+	return resp, nil
+}
+
+// tableResourcePath returns the path of the collection of a resource, in a namespace when one is given
+func tableResourcePath(gvr schema.GroupVersionResource, namespace string) []string {
+	path := []string{"apis", gvr.Group, gvr.Version}
+	if gvr.Group == "" {
+		path = []string{"api", gvr.Version}
+	}
+	if namespace != "" {
+		path = append(path, "namespaces", namespace)
+	}
+	return append(path, gvr.Resource)
+}
+
+// listTablePage requests one page of the Table of a resource. The rows are returned as objects holding
+// their "cells" and the "metadata" of the object, and the column definitions are stored in columns.
+func listTablePage(rc rest.Interface, path []string, columns *[]metav1.TableColumnDefinition) listPageFunc {
+	return func(ctx context.Context, opts metav1.ListOptions) ([]map[string]interface{}, metav1.ListMeta, error) {
+		req := rc.Get().AbsPath(path...).
+			SetHeader("Accept", tableAcceptHeader).
+			Param("includeObject", string(metav1.IncludeMetadata))
+		for k, v := range map[string]string{
+			"labelSelector": opts.LabelSelector,
+			"fieldSelector": opts.FieldSelector,
+			"continue":      opts.Continue,
+		} {
+			if v != "" {
+				req = req.Param(k, v)
+			}
+		}
+		if opts.Limit > 0 {
+			req = req.Param("limit", strconv.FormatInt(opts.Limit, 10))
+		}
+		raw, err := req.DoRaw(ctx)
+		if err != nil {
+			return nil, metav1.ListMeta{}, err
+		}
+		var table metav1.Table
+		if err := json.Unmarshal(raw, &table); err != nil {
+			return nil, metav1.ListMeta{}, err
+		}
+		if table.Kind != "Table" {
+			return nil, metav1.ListMeta{}, fmt.Errorf("the API server returned a %q rather than a Table", table.Kind)
+		}
+		if len(table.ColumnDefinitions) > 0 {
+			*columns = table.ColumnDefinitions
+		}
+		rows := make([]map[string]interface{}, len(table.Rows))
+		for i, r := range table.Rows {
+			var obj metav1.PartialObjectMetadata
+			if len(r.Object.Raw) > 0 {
+				if err := json.Unmarshal(r.Object.Raw, &obj); err != nil {
+					return nil, metav1.ListMeta{}, err
+				}
+			}
+			rows[i] = map[string]interface{}{
+				"cells":     r.Cells,
+				"name":      obj.GetName(),
+				"namespace": obj.GetNamespace(),
+			}
+		}
+		return rows, table.ListMeta, nil
+	}
+}
+
+// tableCell formats the value of a cell as "kubectl get" prints it
+func tableCell(v interface{}) tftypes.Value {
+	switch c := v.(type) {
+	case nil:
+		return tftypes.NewValue(tftypes.String, nil)
+	case string:
+		return tftypes.NewValue(tftypes.String, c)
+	case float64:
+		return tftypes.NewValue(tftypes.String, strconv.FormatFloat(c, 'f', -1, 64))
+	case bool:
+		return tftypes.NewValue(tftypes.String, strconv.FormatBool(c))
+	}
+	js, _ := json.Marshal(v)
+	return tftypes.NewValue(tftypes.String, string(js))
+}
+
+// tableToValues converts the columns and rows of a Table to the values of the "columns" and "rows" attributes
+func tableToValues(columns []metav1.TableColumnDefinition, rows []map[string]interface{}, wide bool) (tftypes.Value, tftypes.Value) {
+	columnValues := []tftypes.Value{}
+	for _, c := range columns {
+		if c.Priority > 0 && !wide {
+			continue
+		}
+		columnValues = append(columnValues, tftypes.NewValue(tableColumnType, map[string]tftypes.Value{
+			"name":        tftypes.NewValue(tftypes.String, c.Name),
+			"type":        tftypes.NewValue(tftypes.String, c.Type),
+			"format":      tftypes.NewValue(tftypes.String, c.Format),
+			"description": tftypes.NewValue(tftypes.String, c.Description),
+			"priority":    tftypes.NewValue(tftypes.Number, big.NewFloat(float64(c.Priority))),
+		}))
+	}
+	rowValues := []tftypes.Value{}
+	for _, r := range rows {
+		cells := map[string]tftypes.Value{}
+		rc, _ := r["cells"].([]interface{})
+		for i, c := range columns {
+			if i >= len(rc) || (c.Priority > 0 && !wide) {
+				continue
+			}
+			cells[c.Name] = tableCell(rc[i])
+		}
+		rowValues = append(rowValues, tftypes.NewValue(tableRowType, map[string]tftypes.Value{
+			"name":      tftypes.NewValue(tftypes.String, r["name"]),
+			"namespace": tftypes.NewValue(tftypes.String, r["namespace"]),
+			"cells":     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, cells),
+		}))
+	}
+	return tftypes.NewValue(tftypes.List{ElementType: tableColumnType}, columnValues),
+		tftypes.NewValue(tftypes.List{ElementType: tableRowType}, rowValues)
+}
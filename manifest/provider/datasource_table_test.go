@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/big"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	restfake "k8s.io/client-go/rest/fake"
+)
+
+func TestTableResourcePath(t *testing.T) {
+	samples := []struct {
+		gvr       schema.GroupVersionResource
+		namespace string
+		expected  string
+	}{
+		{schema.GroupVersionResource{Version: "v1", Resource: "pods"}, "default", "api/v1/namespaces/default/pods"},
+		{schema.GroupVersionResource{Version: "v1", Resource: "nodes"}, "", "api/v1/nodes"},
+		{schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, "", "apis/apps/v1/deployments"},
+	}
+	for _, s := range samples {
+		if p := strings.Join(tableResourcePath(s.gvr, s.namespace), "/"); p != s.expected {
+			t.Errorf("expected %q, got %q", s.expected, p)
+		}
+	}
+}
+
+const testTablePage1 = `{
+  "kind": "Table",
+  "apiVersion": "meta.k8s.io/v1",
+  "metadata": {"resourceVersion": "42", "continue": "page-2"},
+  "columnDefinitions": [
+    {"name": "Name", "type": "string", "format": "name", "description": "Name of the object", "priority": 0},
+    {"name": "Ready", "type": "string", "format": "", "description": "", "priority": 0},
+    {"name": "Restarts", "type": "integer", "format": "", "description": "", "priority": 0},
+    {"name": "IP", "type": "string", "format": "", "description": "", "priority": 1}
+  ],
+  "rows": [
+    {"cells": ["pod-a", "1/1", 0, "10.0.0.1"], "object": {"kind": "PartialObjectMetadata", "apiVersion": "meta.k8s.io/v1", "metadata": {"name": "pod-a", "namespace": "default"}}}
+  ]
+}`
+
+const testTablePage2 = `{
+  "kind": "Table",
+  "apiVersion": "meta.k8s.io/v1",
+  "metadata": {"resourceVersion": "42"},
+  "rows": [
+    {"cells": ["pod-b", "0/1", 3, null], "object": {"kind": "PartialObjectMetadata", "apiVersion": "meta.k8s.io/v1", "metadata": {"name": "pod-b", "namespace": "default"}}}
+  ]
+}`
+
+func TestListTablePage(t *testing.T) {
+	var requests []*http.Request
+	rc := &restfake.RESTClient{
+		NegotiatedSerializer: scheme.Codecs,
+		Client: restfake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			requests = append(requests, req)
+			body := testTablePage1
+			if req.URL.Query().Get("continue") == "page-2" {
+				body = testTablePage2
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(bytes.NewBufferString(body)),
+			}, nil
+		}),
+	}
+
+	var columns []metav1.TableColumnDefinition
+	path := tableResourcePath(schema.GroupVersionResource{Version: "v1", Resource: "pods"}, "default")
+	rows, _, err := listAllPages(context.Background(), listTablePage(rc, path, &columns), metav1.ListOptions{LabelSelector: "app=test"}, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	if a := requests[0].Header.Get("Accept"); !strings.Contains(a, "as=Table") {
+		t.Errorf("expected a Table to be requested, got Accept %q", a)
+	}
+	if q := requests[0].URL.Query(); q.Get("labelSelector") != "app=test" || q.Get("limit") != "1" || q.Get("includeObject") != "Metadata" {
+		t.Errorf("unexpected query %q", requests[0].URL.RawQuery)
+	}
+	if len(columns) != 4 || len(rows) != 2 {
+		t.Fatalf("expected 4 columns and 2 rows, got %d and %d", len(columns), len(rows))
+	}
+
+	cols, rowValues := tableToValues(columns, rows, false)
+	var cl, rl []tftypes.Value
+	cols.As(&cl)
+	rowValues.As(&rl)
+	if len(cl) != 3 {
+		t.Errorf("expected the priority 1 column to be left out, got %d columns", len(cl))
+	}
+	expected := tftypes.NewValue(tableRowType, map[string]tftypes.Value{
+		"name":      tftypes.NewValue(tftypes.String, "pod-b"),
+		"namespace": tftypes.NewValue(tftypes.String, "default"),
+		"cells": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"Name":     tftypes.NewValue(tftypes.String, "pod-b"),
+			"Ready":    tftypes.NewValue(tftypes.String, "0/1"),
+			"Restarts": tftypes.NewValue(tftypes.String, "3"),
+		}),
+	})
+	if !rl[1].Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, rl[1])
+	}
+
+	cols, _ = tableToValues(columns, rows, true)
+	cols.As(&cl)
+	var priority big.Float
+	var c map[string]tftypes.Value
+	cl[3].As(&c)
+	c["priority"].As(&priority)
+	if len(cl) != 4 || priority.Cmp(big.NewFloat(1)) != 0 {
+		t.Errorf("expected the priority 1 column with wide, got %v", cl)
+	}
+}
+
+func TestTableCell(t *testing.T) {
+	samples := []struct {
+		in       interface{}
+		expected interface{}
+	}{
+		{nil, nil},
+		{"Running", "Running"},
+		{float64(3), "3"},
+		{float64(0.5), "0.5"},
+		{true, "true"},
+		{[]interface{}{"a", "b"}, `["a","b"]`},
+	}
+	for _, s := range samples {
+		v := tableCell(s.in)
+		var out *string
+		v.As(&out)
+		var got interface{}
+		if out != nil {
+			got = *out
+		}
+		if !reflect.DeepEqual(got, s.expected) {
+			t.Errorf("expected %#v, got %#v", s.expected, got)
+		}
+	}
+}
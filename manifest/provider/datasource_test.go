@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeListPages serves total objects in pages of the requested size and records the options of each call
+func fakeListPages(total int, calls *[]metav1.ListOptions) listPageFunc {
+	return func(ctx context.Context, opts metav1.ListOptions) ([]map[string]interface{}, metav1.ListMeta, error) {
+		*calls = append(*calls, opts)
+		start := 0
+		if opts.Continue != "" {
+			start, _ = strconv.Atoi(opts.Continue)
+		}
+		end := start + int(opts.Limit)
+		if end > total {
+			end = total
+		}
+		var items []map[string]interface{}
+		for i := start; i < end; i++ {
+			items = append(items, map[string]interface{}{"metadata": map[string]interface{}{"name": fmt.Sprintf("obj-%d", i)}})
+		}
+		lm := metav1.ListMeta{ResourceVersion: "42"}
+		if end < total {
+			lm.Continue = strconv.Itoa(end)
+		}
+		return items, lm, nil
+	}
+}
+
+func TestListAllPages(t *testing.T) {
+	samples := []struct {
+		total    int
+		limit    int64
+		pageSize int64
+		count    int
+		calls    int
+	}{
+		{total: 10, limit: 0, pageSize: 3, count: 10, calls: 4},
+		{total: 10, limit: 5, pageSize: 3, count: 5, calls: 2},
+		{total: 10, limit: 20, pageSize: 0, count: 10, calls: 1},
+		{total: 0, limit: 0, pageSize: 0, count: 0, calls: 1},
+	}
+	for _, s := range samples {
+		t.Run(fmt.Sprintf("total=%d,limit=%d,page=%d", s.total, s.limit, s.pageSize), func(t *testing.T) {
+			var calls []metav1.ListOptions
+			opts := metav1.ListOptions{ResourceVersion: "40", ResourceVersionMatch: metav1.ResourceVersionMatchNotOlderThan}
+			items, rv, err := listAllPages(context.Background(), fakeListPages(s.total, &calls), opts, s.limit, s.pageSize)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(items) != s.count {
+				t.Errorf("expected %d objects, got %d", s.count, len(items))
+			}
+			if len(calls) != s.calls {
+				t.Errorf("expected %d list calls, got %d", s.calls, len(calls))
+			}
+			if rv != "42" {
+				t.Errorf("expected resourceVersion %q, got %q", "42", rv)
+			}
+			if calls[0].ResourceVersion != "40" {
+				t.Errorf("expected the first page to be listed at resourceVersion %q, got %q", "40", calls[0].ResourceVersion)
+			}
+			for _, c := range calls[1:] {
+				if c.ResourceVersion != "" || c.ResourceVersionMatch != "" {
+					t.Errorf("expected no resourceVersion with a continue token, got %q (%s)", c.ResourceVersion, c.ResourceVersionMatch)
+				}
+			}
+		})
+	}
+}
+
+func TestMetadataObjectType(t *testing.T) {
+	ot := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"apiVersion": tftypes.String,
+		"kind":       tftypes.String,
+		"metadata":   tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}},
+		"spec":       tftypes.Object{AttributeTypes: map[string]tftypes.Type{"replicas": tftypes.Number}},
+	}}
+	mt := metadataObjectType(ot).(tftypes.Object)
+	if len(mt.AttributeTypes) != 3 {
+		t.Fatalf("expected apiVersion, kind and metadata, got %v", mt.AttributeTypes)
+	}
+	if _, ok := mt.AttributeTypes["spec"]; ok {
+		t.Errorf("expected spec to be removed")
+	}
+	if !metadataObjectType(tftypes.DynamicPseudoType).Is(tftypes.DynamicPseudoType) {
+		t.Errorf("expected dynamic types to be left as is")
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v4/value"
+)
+
+const (
+	driftPolicyIgnore = "ignore"
+	driftPolicyWarn   = "warn"
+	driftPolicyError  = "error"
+)
+
+// getDriftPolicy returns the configured drift policy of the resource
+func getDriftPolicy(v map[string]tftypes.Value) string {
+	policy := driftPolicyIgnore
+	if dp, ok := v["drift_policy"]; ok && !dp.IsNull() && dp.IsKnown() {
+		dp.As(&policy)
+	}
+	return policy
+}
+
+// detectDrift reports the fields set in the manifest which were changed outside of Terraform since the last apply,
+// or which are now owned by other field managers, as recorded in the managedFields of the live object.
+// The prior object (obj) is expected to have been refreshed from the live object. Fields at or below skipFields are not checked.
+// The diagnostics are warnings or errors, depending on the drift policy.
+func (s *RawProviderServer) detectDrift(ctx context.Context, policy string, gvk schema.GroupVersionKind, cfg, priorMan, morphed, obj tftypes.Value, fieldManager string, namespaced bool, skipFields map[string]*tftypes.AttributePath) []*tfprotov5.Diagnostic {
+	severity := tfprotov5.DiagnosticSeverityWarning
+	if policy == driftPolicyError {
+		severity = tfprotov5.DiagnosticSeverityError
+	}
+
+	rm, err := s.getRestMapper()
+	if err != nil {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to create K8s RESTMapper client",
+			Detail:   err.Error(),
+		}}
+	}
+	mapping, err := rm.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to determine GroupVersionResource for manifest",
+			Detail:   err.Error(),
+		}}
+	}
+	client, err := s.getDynamicClient()
+	if err != nil {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "failed to get Dynamic client",
+			Detail:   err.Error(),
+		}}
+	}
+	rname := stringAttribute(obj, "metadata", "name")
+	rnamespace := stringAttribute(obj, "metadata", "namespace")
+	rcl := client.Resource(mapping.Resource)
+	var live *unstructured.Unstructured
+	if namespaced {
+		live, err = rcl.Namespace(rnamespace).Get(ctx, rname, metav1.GetOptions{})
+	} else {
+		live, err = rcl.Get(ctx, rname, metav1.GetOptions{})
+	}
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  fmt.Sprintf("Cannot GET resource %q to detect drift", rname),
+			Detail:   err.Error(),
+		}}
+	}
+
+	fo, err := newFieldOwnership(live.Object, live.GetManagedFields(), fieldManager)
+	if err != nil {
+		s.logger.Debug("[detectDrift]", "cannot parse managedFields of", rname, "error", err)
+		return nil
+	}
+
+	var diags []*tfprotov5.Diagnostic
+	tftypes.Walk(morphed, func(ap *tftypes.AttributePath, v tftypes.Value) (bool, error) {
+		if hasPathPrefix(ap, skipFields) {
+			return false, nil
+		}
+		if v.IsNull() || !v.IsKnown() || !isPrimitiveType(v.Type()) {
+			return true, nil
+		}
+		keys := attributePathKeys(ap)
+		if len(keys) == 0 {
+			return true, nil
+		}
+		attr := tftypes.NewAttributePathWithSteps(append(tftypes.NewAttributePath().WithAttributeName("manifest").Steps(), ap.Steps()...))
+		owner, takenOver := fo.takenOver(keys)
+
+		if unchangedInConfig(ap, cfg, priorMan) && changedInObject(ap, v, obj) {
+			detail := fmt.Sprintf("The value of %q was changed outside of Terraform since the last apply.", ap.String())
+			if owner != "" {
+				detail = fmt.Sprintf("The value of %q was changed by field manager %q since the last apply.", ap.String(), owner)
+			}
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity:  severity,
+				Summary:   "Field changed outside of Terraform",
+				Detail:    detail + " Applying will restore the configured value.",
+				Attribute: attr,
+			})
+			return true, nil
+		}
+		if takenOver {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity: severity,
+				Summary:  "Field managed by another field manager",
+				Detail: fmt.Sprintf("Field manager %q has taken ownership of %q, which is set in the manifest. "+
+					"Applying a different value will conflict unless \"force_conflicts\" is set in the \"field_manager\" block.", owner, ap.String()),
+				Attribute: attr,
+			})
+		}
+		return true, nil
+	})
+	return diags
+}
+
+// fieldOwnership records which field managers own the fields of an object.
+// Fields are identified by the keys produced by attributePathKeys.
+type fieldOwnership struct {
+	hasOwn bool
+	own    map[string]bool
+	others map[string]string
+}
+
+// newFieldOwnership collects the leaf fields owned by each manager in the managedFields of an object,
+// separating those of the given field manager from those of any other manager
+func newFieldOwnership(obj map[string]interface{}, entries []metav1.ManagedFieldsEntry, manager string) (*fieldOwnership, error) {
+	fo := &fieldOwnership{
+		own:    make(map[string]bool),
+		others: make(map[string]string),
+	}
+	for _, e := range entries {
+		if e.Subresource != "" || e.FieldsV1 == nil {
+			continue
+		}
+		set := &fieldpath.Set{}
+		if err := set.FromJSON(bytes.NewReader(e.FieldsV1.Raw)); err != nil {
+			return nil, err
+		}
+		isOwn := e.Manager == manager
+		if isOwn {
+			fo.hasOwn = true
+		}
+		set.Leaves().Iterate(func(p fieldpath.Path) {
+			key, ok := resolveFieldPath(obj, p)
+			if !ok {
+				return
+			}
+			if isOwn {
+				fo.own[key] = true
+			} else if _, ok := fo.others[key]; !ok {
+				fo.others[key] = e.Manager
+			}
+		})
+	}
+	return fo, nil
+}
+
+// takenOver returns the manager owning the field identified by the keys of its path (or one of its
+// atomic parents) and whether the field has been taken away from our own field manager
+func (fo *fieldOwnership) takenOver(keys []string) (string, bool) {
+	var owner string
+	owned := false
+	for i := len(keys) - 1; i >= 0; i-- {
+		if fo.own[keys[i]] {
+			owned = true
+		}
+		if m, ok := fo.others[keys[i]]; ok && owner == "" {
+			owner = m
+		}
+	}
+	return owner, fo.hasOwn && !owned && owner != ""
+}
+
+// resolveFieldPath follows a managedFields path through an object, returning the key of the field
+// in the form produced by attributePathKeys. Elements of associative lists are resolved to their index.
+func resolveFieldPath(obj interface{}, p fieldpath.Path) (string, bool) {
+	var b strings.Builder
+	cur := obj
+	for _, pe := range p {
+		if pe.FieldName != nil {
+			m, ok := cur.(map[string]interface{})
+			if !ok {
+				return "", false
+			}
+			cur, ok = m[*pe.FieldName]
+			if !ok {
+				return "", false
+			}
+			fmt.Fprintf(&b, ".%q", *pe.FieldName)
+			continue
+		}
+		l, ok := cur.([]interface{})
+		if !ok {
+			return "", false
+		}
+		idx := listElementIndex(l, pe)
+		if idx < 0 {
+			return "", false
+		}
+		cur = l[idx]
+		fmt.Fprintf(&b, "[%d]", idx)
+	}
+	return b.String(), true
+}
+
+// listElementIndex finds the element of a list selected by a managedFields path element
+func listElementIndex(l []interface{}, pe fieldpath.PathElement) int {
+	switch {
+	case pe.Index != nil:
+		if *pe.Index < len(l) {
+			return *pe.Index
+		}
+	case pe.Value != nil:
+		for i, el := range l {
+			if value.Equals(value.NewValueInterface(el), *pe.Value) {
+				return i
+			}
+		}
+	case pe.Key != nil:
+	elements:
+		for i, el := range l {
+			m, ok := el.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, f := range *pe.Key {
+				fv, ok := m[f.Name]
+				if !ok || !value.Equals(value.NewValueInterface(fv), f.Value) {
+					continue elements
+				}
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+// attributePathKeys returns the keys identifying an attribute path and each of its parents, shortest first
+func attributePathKeys(ap *tftypes.AttributePath) []string {
+	var b strings.Builder
+	keys := make([]string, 0, len(ap.Steps()))
+	for _, s := range ap.Steps() {
+		switch st := s.(type) {
+		case tftypes.AttributeName:
+			fmt.Fprintf(&b, ".%q", string(st))
+		case tftypes.ElementKeyString:
+			fmt.Fprintf(&b, ".%q", string(st))
+		case tftypes.ElementKeyInt:
+			fmt.Fprintf(&b, "[%d]", int64(st))
+		default:
+			return keys
+		}
+		keys = append(keys, b.String())
+	}
+	return keys
+}
+
+func isPrimitiveType(t tftypes.Type) bool {
+	return t.Is(tftypes.String) || t.Is(tftypes.Number) || t.Is(tftypes.Bool)
+}
+
+// unchangedInConfig checks whether an attribute has the same value in the current and the prior configuration
+func unchangedInConfig(ap *tftypes.AttributePath, cfg, priorMan tftypes.Value) bool {
+	now, restPath, err := tftypes.WalkAttributePath(cfg, ap)
+	if err != nil || len(restPath.Steps()) > 0 {
+		return false
+	}
+	was, restPath, err := tftypes.WalkAttributePath(priorMan, ap)
+	if err != nil || len(restPath.Steps()) > 0 {
+		return false
+	}
+	return was.(tftypes.Value).Equal(now.(tftypes.Value))
+}
+
+// changedInObject checks whether the object no longer holds the value of an attribute
+func changedInObject(ap *tftypes.AttributePath, v, obj tftypes.Value) bool {
+	cur, restPath, err := tftypes.WalkAttributePath(obj, ap)
+	if err != nil || len(restPath.Steps()) > 0 {
+		return true
+	}
+	cv := cur.(tftypes.Value)
+	if !cv.Type().Equal(v.Type()) {
+		// values of dynamically typed attributes cannot be compared reliably
+		return false
+	}
+	return cv.IsKnown() && !cv.Equal(v)
+}
+
+func stringAttribute(obj tftypes.Value, names ...string) string {
+	ap := tftypes.NewAttributePath()
+	for _, n := range names {
+		ap = ap.WithAttributeName(n)
+	}
+	var s string
+	v, restPath, err := tftypes.WalkAttributePath(obj, ap)
+	if err != nil || len(restPath.Steps()) > 0 {
+		return s
+	}
+	v.(tftypes.Value).As(&s)
+	return s
+}
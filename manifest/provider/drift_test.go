@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testDriftObject = `{
+  "apiVersion": "apps/v1",
+  "kind": "Deployment",
+  "metadata": {
+    "name": "app",
+    "namespace": "default",
+    "managedFields": [
+      {
+        "manager": "Terraform",
+        "operation": "Apply",
+        "fieldsType": "FieldsV1",
+        "fieldsV1": {
+          "f:spec": {
+            "f:template": {
+              "f:spec": {
+                "f:containers": {
+                  "k:{\"name\":\"app\"}": {
+                    ".": {},
+                    "f:name": {},
+                    "f:image": {}
+                  }
+                }
+              }
+            }
+          }
+        }
+      },
+      {
+        "manager": "kubectl-scale",
+        "operation": "Update",
+        "fieldsType": "FieldsV1",
+        "fieldsV1": {"f:spec": {"f:replicas": {}}}
+      },
+      {
+        "manager": "hpa-controller",
+        "operation": "Update",
+        "subresource": "status",
+        "fieldsType": "FieldsV1",
+        "fieldsV1": {"f:status": {"f:replicas": {}}}
+      },
+      {
+        "manager": "kubectl-edit",
+        "operation": "Update",
+        "fieldsType": "FieldsV1",
+        "fieldsV1": {
+          "f:spec": {
+            "f:template": {
+              "f:spec": {
+                "f:containers": {
+                  "k:{\"name\":\"sidecar\"}": {
+                    "f:image": {}
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    ]
+  },
+  "spec": {
+    "replicas": 5,
+    "template": {
+      "spec": {
+        "containers": [
+          {"name": "sidecar", "image": "proxy:2"},
+          {"name": "app", "image": "app:1"}
+        ]
+      }
+    }
+  },
+  "status": {"replicas": 5}
+}`
+
+func TestFieldOwnership(t *testing.T) {
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(testDriftObject), &obj); err != nil {
+		t.Fatal(err)
+	}
+	var meta struct {
+		Metadata metav1.ObjectMeta `json:"metadata"`
+	}
+	if err := json.Unmarshal([]byte(testDriftObject), &meta); err != nil {
+		t.Fatal(err)
+	}
+	fo, err := newFieldOwnership(obj, meta.Metadata.ManagedFields, "Terraform")
+	if err != nil {
+		t.Fatalf("failed to collect field owners: %s", err)
+	}
+
+	container := func(i int) *tftypes.AttributePath {
+		return tftypes.NewAttributePath().WithAttributeName("spec").WithAttributeName("template").
+			WithAttributeName("spec").WithAttributeName("containers").WithElementKeyInt(i)
+	}
+	samples := map[string]struct {
+		path      *tftypes.AttributePath
+		owner     string
+		takenOver bool
+	}{
+		"replicas": {
+			path:      tftypes.NewAttributePath().WithAttributeName("spec").WithAttributeName("replicas"),
+			owner:     "kubectl-scale",
+			takenOver: true,
+		},
+		"own": {
+			path: container(1).WithAttributeName("image"),
+		},
+		"keyed": {
+			path:      container(0).WithAttributeName("image"),
+			owner:     "kubectl-edit",
+			takenOver: true,
+		},
+		"subresource": {
+			path: tftypes.NewAttributePath().WithAttributeName("status").WithAttributeName("replicas"),
+		},
+	}
+	for name, s := range samples {
+		t.Run(name, func(t *testing.T) {
+			owner, takenOver := fo.takenOver(attributePathKeys(s.path))
+			if owner != s.owner || takenOver != s.takenOver {
+				t.Errorf("expected (%q, %t), got (%q, %t)", s.owner, s.takenOver, owner, takenOver)
+			}
+		})
+	}
+}
+
+func TestAttributePathKeys(t *testing.T) {
+	ap := tftypes.NewAttributePath().WithAttributeName("metadata").WithAttributeName("labels").WithElementKeyString("app.kubernetes.io/name")
+	keys := attributePathKeys(ap)
+	expected := []string{`."metadata"`, `."metadata"."labels"`, `."metadata"."labels"."app.kubernetes.io/name"`}
+	if len(keys) != len(expected) {
+		t.Fatalf("expected %d keys, got %d", len(expected), len(keys))
+	}
+	for i := range expected {
+		if keys[i] != expected[i] {
+			t.Errorf("expected key %q, got %q", expected[i], keys[i])
+		}
+	}
+}
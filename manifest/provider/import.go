@@ -25,6 +25,15 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 	// Presumably the Kubernetes API machinery already has a standard for expressing such a group. We should look there first.
 	resp := &tfprotov5.ImportResourceStateResponse{}
 
+	if req.TypeName == manifestsResourceType {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Import is not supported",
+			Detail:   "The kubernetes_manifests resource cannot be imported. Apply the bundle to take over the existing objects.",
+		})
+		return resp, nil
+	}
+
 	cp := req.ClientCapabilities
 	if cp != nil && cp.DeferralAllowed && s.clientConfigUnknown {
 		v := tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue)
@@ -165,6 +174,11 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 	timeoutsType := rt.(tftypes.Object).AttributeTypes["timeouts"]
 	fmType := rt.(tftypes.Object).AttributeTypes["field_manager"]
 	cmpType := rt.(tftypes.Object).AttributeTypes["computed_fields"]
+	dpType := rt.(tftypes.Object).AttributeTypes["delete_propagation"]
+	rfType := rt.(tftypes.Object).AttributeTypes["remove_finalizers_on_timeout"]
+	dpolType := rt.(tftypes.Object).AttributeTypes["drift_policy"]
+	ifType := rt.(tftypes.Object).AttributeTypes["ignore_fields"]
+	delpType := rt.(tftypes.Object).AttributeTypes["deletion_policy"]
 
 	newState["manifest"] = tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, nil)
 	newState["object"] = morph.UnknownToNull(nobj)
@@ -173,6 +187,11 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 	newState["timeouts"] = tftypes.NewValue(timeoutsType, nil)
 	newState["field_manager"] = tftypes.NewValue(fmType, nil)
 	newState["computed_fields"] = tftypes.NewValue(cmpType, nil)
+	newState["delete_propagation"] = tftypes.NewValue(dpType, nil)
+	newState["remove_finalizers_on_timeout"] = tftypes.NewValue(rfType, nil)
+	newState["drift_policy"] = tftypes.NewValue(dpolType, nil)
+	newState["ignore_fields"] = tftypes.NewValue(ifType, nil)
+	newState["deletion_policy"] = tftypes.NewValue(delpType, nil)
 
 	nsVal := tftypes.NewValue(rt, newState)
 
@@ -0,0 +1,935 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest/payload"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+)
+
+const manifestsResourceType = "kubernetes_manifests"
+
+// inventoryLabel marks the objects applied by a kubernetes_manifests resource,
+// so that only those are pruned or deleted by it
+const inventoryLabel = "terraform.io/inventory-id"
+
+// mappingWaitTimeout is how long to wait for the API server to serve a kind
+// whose CustomResourceDefinition was applied earlier in the same bundle
+const mappingWaitTimeout = 2 * time.Minute
+
+// manifestsApplyOrder is the order in which objects of well-known kinds are applied: namespaces and
+// CRDs first, then the objects workloads depend on, and admission webhooks last.
+// Objects of other kinds, such as custom resources, are applied after all of these.
+var manifestsApplyOrder = []string{
+	"Namespace",
+	"CustomResourceDefinition",
+	"PriorityClass",
+	"NetworkPolicy",
+	"ResourceQuota",
+	"LimitRange",
+	"PodDisruptionBudget",
+	"ServiceAccount",
+	"Secret",
+	"ConfigMap",
+	"StorageClass",
+	"PersistentVolume",
+	"PersistentVolumeClaim",
+	"ClusterRole",
+	"ClusterRoleBinding",
+	"Role",
+	"RoleBinding",
+	"Service",
+	"DaemonSet",
+	"Pod",
+	"ReplicationController",
+	"ReplicaSet",
+	"Deployment",
+	"HorizontalPodAutoscaler",
+	"StatefulSet",
+	"Job",
+	"CronJob",
+	"IngressClass",
+	"Ingress",
+	"APIService",
+	"MutatingWebhookConfiguration",
+	"ValidatingWebhookConfiguration",
+}
+
+func manifestsKindPriority(kind string) int {
+	for i, k := range manifestsApplyOrder {
+		if k == kind {
+			return i
+		}
+	}
+	return len(manifestsApplyOrder)
+}
+
+// manifestsObjectKey identifies an object of the bundle as "<apiVersion>/<kind>/[<namespace>/]<name>"
+func manifestsObjectKey(u *unstructured.Unstructured) string {
+	parts := []string{u.GetAPIVersion(), u.GetKind()}
+	if ns := u.GetNamespace(); ns != "" {
+		parts = append(parts, ns)
+	}
+	return strings.Join(append(parts, u.GetName()), "/")
+}
+
+// sortManifestsKeys returns the keys of the objects in the order they are applied
+func sortManifestsKeys(objs map[string]*unstructured.Unstructured) []string {
+	keys := make([]string, 0, len(objs))
+	for k := range objs {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		pi, pj := manifestsKindPriority(objs[keys[i]].GetKind()), manifestsKindPriority(objs[keys[j]].GetKind())
+		if pi != pj {
+			return pi < pj
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+// decodeManifestsContent decodes a stream of YAML or JSON documents
+func decodeManifestsContent(content string) ([]map[string]interface{}, error) {
+	var docs []map[string]interface{}
+	dec := k8syaml.NewYAMLOrJSONDecoder(strings.NewReader(content), 4096)
+	for {
+		var doc map[string]interface{}
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return docs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %s", len(docs), err)
+		}
+		if len(doc) > 0 {
+			docs = append(docs, doc)
+		}
+	}
+}
+
+// decodeManifestsObjects converts the list of objects of the "objects" attribute
+func decodeManifestsObjects(v tftypes.Value) ([]map[string]interface{}, error) {
+	if !v.Type().Is(tftypes.List{}) && !v.Type().Is(tftypes.Tuple{}) {
+		return nil, errors.New("must be a list of objects")
+	}
+	o, err := payload.FromTFValue(v, nil, tftypes.NewAttributePath().WithAttributeName("objects"))
+	if err != nil {
+		return nil, err
+	}
+	var docs []map[string]interface{}
+	for i, e := range o.([]interface{}) {
+		doc, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("element %d is not an object", i)
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// expandManifestsLists replaces the "List" documents by their items
+func expandManifestsLists(docs []map[string]interface{}) []map[string]interface{} {
+	var out []map[string]interface{}
+	for _, d := range docs {
+		u := unstructured.Unstructured{Object: d}
+		if !strings.HasSuffix(u.GetKind(), "List") || !u.IsList() {
+			out = append(out, d)
+			continue
+		}
+		items, _, _ := unstructured.NestedSlice(d, "items")
+		for _, i := range items {
+			if m, ok := i.(map[string]interface{}); ok {
+				out = append(out, m)
+			}
+		}
+	}
+	return out
+}
+
+// crdScopes returns whether the kinds defined by the CRDs of the bundle are namespaced
+func crdScopes(docs []map[string]interface{}) map[schema.GroupKind]bool {
+	scopes := make(map[schema.GroupKind]bool)
+	for _, d := range docs {
+		u := unstructured.Unstructured{Object: d}
+		if u.GroupVersionKind().GroupKind() != (schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}) {
+			continue
+		}
+		group, _, _ := unstructured.NestedString(d, "spec", "group")
+		kind, _, _ := unstructured.NestedString(d, "spec", "names", "kind")
+		scope, _, _ := unstructured.NestedString(d, "spec", "scope")
+		scopes[schema.GroupKind{Group: group, Kind: kind}] = scope == "Namespaced"
+	}
+	return scopes
+}
+
+// manifestsFromConfig decodes the objects of the bundle, keyed by manifestsObjectKey.
+// Namespaced objects without a namespace are placed in the "default" namespace.
+func (s *RawProviderServer) manifestsFromConfig(v map[string]tftypes.Value) (map[string]*unstructured.Unstructured, []*tfprotov5.Diagnostic) {
+	var docs []map[string]interface{}
+	var err error
+	attr := tftypes.NewAttributePath().WithAttributeName("content")
+	if !v["content"].IsNull() {
+		var content string
+		v["content"].As(&content)
+		docs, err = decodeManifestsContent(content)
+	} else {
+		attr = tftypes.NewAttributePath().WithAttributeName("objects")
+		docs, err = decodeManifestsObjects(v["objects"])
+	}
+	if err != nil {
+		return nil, []*tfprotov5.Diagnostic{{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Failed to decode the objects of the bundle",
+			Detail:    err.Error(),
+			Attribute: attr,
+		}}
+	}
+	docs = expandManifestsLists(docs)
+	scopes := crdScopes(docs)
+
+	var diags []*tfprotov5.Diagnostic
+	objs := make(map[string]*unstructured.Unstructured, len(docs))
+	for i, d := range docs {
+		u := &unstructured.Unstructured{Object: mapRemoveNulls(d)}
+		unstructured.RemoveNestedField(u.Object, "status")
+		if u.GetAPIVersion() == "" || u.GetKind() == "" || u.GetName() == "" {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid object in bundle",
+				Detail:    fmt.Sprintf("Object %d is missing one of \"apiVersion\", \"kind\" or \"metadata.name\".", i),
+				Attribute: attr,
+			})
+			continue
+		}
+		if u.GetNamespace() == "" {
+			gk := u.GroupVersionKind().GroupKind()
+			namespaced, ok := scopes[gk]
+			if !ok {
+				namespaced, err = s.isKindNamespaced(u.GroupVersionKind())
+				if err != nil {
+					diags = append(diags, &tfprotov5.Diagnostic{
+						Severity:  tfprotov5.DiagnosticSeverityError,
+						Summary:   "Failed to determine the scope of an object in bundle",
+						Detail:    fmt.Sprintf("%s: %s", manifestsObjectKey(u), err),
+						Attribute: attr,
+					})
+					continue
+				}
+			}
+			if namespaced {
+				u.SetNamespace("default")
+			}
+		}
+		key := manifestsObjectKey(u)
+		if _, ok := objs[key]; ok {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Duplicate object in bundle",
+				Detail:    fmt.Sprintf("%s is defined more than once.", key),
+				Attribute: attr,
+			})
+			continue
+		}
+		objs[key] = u
+	}
+	return objs, diags
+}
+
+func (s *RawProviderServer) isKindNamespaced(gvk schema.GroupVersionKind) (bool, error) {
+	rm, err := s.getRestMapper()
+	if err != nil {
+		return false, err
+	}
+	return IsResourceNamespaced(gvk, rm)
+}
+
+// manifestsFromState decodes the "manifests" attribute of the resource
+func manifestsFromState(v tftypes.Value) (map[string]*unstructured.Unstructured, error) {
+	objs := make(map[string]*unstructured.Unstructured)
+	if v.IsNull() || !v.IsKnown() {
+		return objs, nil
+	}
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		return nil, err
+	}
+	for k, e := range m {
+		var js string
+		if err := e.As(&js); err != nil {
+			return nil, err
+		}
+		u := &unstructured.Unstructured{}
+		if err := u.UnmarshalJSON([]byte(js)); err != nil {
+			return nil, fmt.Errorf("%s: %s", k, err)
+		}
+		objs[k] = u
+	}
+	return objs, nil
+}
+
+func manifestsToValue(objs map[string]*unstructured.Unstructured) (tftypes.Value, error) {
+	m := make(map[string]tftypes.Value, len(objs))
+	for k, u := range objs {
+		js, err := json.Marshal(u.Object)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		m[k] = tftypes.NewValue(tftypes.String, string(js))
+	}
+	return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, m), nil
+}
+
+// manifestsResourceInterface returns the client for the resource of an object of the bundle.
+// With waitForKind it waits for kinds defined by CRDs applied earlier to be served by the API server.
+func (s *RawProviderServer) manifestsResourceInterface(ctx context.Context, u *unstructured.Unstructured, waitForKind bool) (dynamic.ResourceInterface, error) {
+	rm, err := s.getRestMapper()
+	if err != nil {
+		return nil, err
+	}
+	gvk := u.GroupVersionKind()
+	mapping, err := rm.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) && waitForKind {
+		perr := wait.PollUntilContextTimeout(ctx, time.Second, mappingWaitTimeout, true, func(ctx context.Context) (bool, error) {
+			if r, ok := rm.(meta.ResettableRESTMapper); ok {
+				r.Reset()
+			}
+			mapping, err = rm.RESTMapping(gvk.GroupKind(), gvk.Version)
+			return !meta.IsNoMatchError(err), nil
+		})
+		if perr != nil && err == nil {
+			err = perr
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.getDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return client.Resource(mapping.Resource).Namespace(u.GetNamespace()), nil
+	}
+	return client.Resource(mapping.Resource), nil
+}
+
+// deleteManifestsObject deletes an object of the bundle, unless it has been taken over by
+// another inventory or is already gone
+func (s *RawProviderServer) deleteManifestsObject(ctx context.Context, u *unstructured.Unstructured, inventoryID string) error {
+	rs, err := s.manifestsResourceInterface(ctx, u, false)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+	live, err := rs.Get(ctx, u.GetName(), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if id := live.GetLabels()[inventoryLabel]; id != inventoryID {
+		s.logger.Warn("[kubernetes_manifests] not deleting object owned by another inventory", "object", manifestsObjectKey(u), "inventory", id)
+		return nil
+	}
+	propagation := metav1.DeletePropagationBackground
+	err = rs.Delete(ctx, u.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *RawProviderServer) validateManifestsResource(ctx context.Context, req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	resp := &tfprotov5.ValidateResourceTypeConfigResponse{}
+	rt, err := GetResourceType(req.TypeName)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to determine resource type",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	config, err := req.Config.Unmarshal(rt)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to unmarshal resource state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	configVal := make(map[string]tftypes.Value)
+	if err := config.As(&configVal); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to extract resource state from SDK value",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	content, objects := configVal["content"], configVal["objects"]
+	if !content.IsNull() && !objects.IsNull() {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Conflicting attributes",
+			Detail:    `Only one of "content" or "objects" can be set.`,
+			Attribute: tftypes.NewAttributePath().WithAttributeName("objects"),
+		})
+	}
+	if content.IsKnown() && content.IsNull() && objects.IsKnown() && objects.IsNull() {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Missing objects",
+			Detail:   `One of "content" or "objects" must be set.`,
+		})
+	}
+
+	if dp, ok := configVal["deletion_policy"]; ok && !dp.IsNull() && dp.IsKnown() {
+		var policy string
+		dp.As(&policy)
+		switch policy {
+		case deletionPolicyDelete, deletionPolicyRetain, deletionPolicyAbandon:
+		default:
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid deletion_policy",
+				Detail:    fmt.Sprintf(`%q is not a valid deletion policy. Must be one of "delete", "retain" or "abandon".`, policy),
+				Attribute: tftypes.NewAttributePath().WithAttributeName("deletion_policy"),
+			})
+		}
+	}
+	as, err := getApplySetConfig(configVal, "")
+	if err == nil && as != nil && as.kind != "Secret" && as.kind != "ConfigMap" {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Invalid ApplySet parent kind",
+			Detail:    fmt.Sprintf(`%q is not a valid ApplySet parent kind. Must be one of "Secret" or "ConfigMap".`, as.kind),
+			Attribute: tftypes.NewAttributePath().WithAttributeName("applyset").WithElementKeyInt(0).WithAttributeName("kind"),
+		})
+	}
+	return resp, nil
+}
+
+func (s *RawProviderServer) planManifestsResource(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp := &tfprotov5.PlanResourceChangeResponse{}
+	rt, err := GetResourceType(req.TypeName)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to determine planned resource type",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	proposedState, err := req.ProposedNewState.Unmarshal(rt)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to unmarshal planned resource state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	if proposedState.IsNull() {
+		// the objects are deleted
+		resp.PlannedState = req.ProposedNewState
+		return resp, nil
+	}
+	priorState, err := req.PriorState.Unmarshal(rt)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to unmarshal prior resource state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	proposedVal := make(map[string]tftypes.Value)
+	if err := proposedState.As(&proposedVal); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to extract planned resource state from tftypes.Value",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	priorVal := make(map[string]tftypes.Value)
+	if !priorState.IsNull() {
+		if err := priorState.As(&priorVal); err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to extract prior resource state from tftypes.Value",
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+	}
+
+	// an inventory ID set in the configuration replaces a generated one, or the objects would no longer be pruned
+	if proposedVal["inventory_id"].IsNull() {
+		if id, ok := priorVal["inventory_id"]; ok && !id.IsNull() {
+			proposedVal["inventory_id"] = id
+		} else {
+			proposedVal["inventory_id"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+		}
+	} else if id, ok := priorVal["inventory_id"]; ok && !id.IsNull() && !id.Equal(proposedVal["inventory_id"]) {
+		resp.RequiresReplace = append(resp.RequiresReplace, tftypes.NewAttributePath().WithAttributeName("inventory_id"))
+	}
+
+	unknownManifests := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue)
+	canDefer := req.ClientCapabilities != nil && req.ClientCapabilities.DeferralAllowed
+	switch {
+	case canDefer && s.clientConfigUnknown:
+		proposedVal["manifests"] = unknownManifests
+		resp.Deferred = &tfprotov5.Deferred{
+			Reason: tfprotov5.DeferredReasonProviderConfigUnknown,
+		}
+	case !proposedVal["content"].IsFullyKnown() || !proposedVal["objects"].IsFullyKnown():
+		proposedVal["manifests"] = unknownManifests
+	default:
+		execDiag := s.canExecute()
+		if len(execDiag) > 0 {
+			resp.Diagnostics = append(resp.Diagnostics, execDiag...)
+			return resp, nil
+		}
+		resp.Diagnostics = append(resp.Diagnostics, s.checkValidCredentials(ctx)...)
+		if len(resp.Diagnostics) > 0 {
+			return resp, nil
+		}
+		objs, diags := s.manifestsFromConfig(proposedVal)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		if len(diags) > 0 {
+			return resp, nil
+		}
+		if as, _ := getApplySetConfig(proposedVal, ""); as != nil {
+			for k, u := range objs {
+				if objectIdentity(u.GroupVersionKind().GroupKind(), u.GetNamespace(), u.GetName()) == as.identity() {
+					resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+						Severity:  tfprotov5.DiagnosticSeverityError,
+						Summary:   "ApplySet parent in bundle",
+						Detail:    fmt.Sprintf("%s is the parent object of the ApplySet and cannot be part of the bundle.", k),
+						Attribute: tftypes.NewAttributePath().WithAttributeName("applyset"),
+					})
+					return resp, nil
+				}
+			}
+		}
+		proposedVal["manifests"], err = manifestsToValue(objs)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to encode the objects of the bundle",
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+	}
+
+	plannedState := tftypes.NewValue(proposedState.Type(), proposedVal)
+	ps, err := tfprotov5.NewDynamicValue(plannedState.Type(), plannedState)
+	if err != nil {
+		return resp, err
+	}
+	resp.PlannedState = &ps
+	return resp, nil
+}
+
+func (s *RawProviderServer) applyManifestsResource(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	resp := &tfprotov5.ApplyResourceChangeResponse{}
+
+	execDiag := s.canExecute()
+	if len(execDiag) > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, execDiag...)
+		return resp, nil
+	}
+
+	rt, err := GetResourceType(req.TypeName)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to determine planned resource type",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	plannedState, err := req.PlannedState.Unmarshal(rt)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to unmarshal planned resource state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	priorState, err := req.PriorState.Unmarshal(rt)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to unmarshal prior resource state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	priorVal := make(map[string]tftypes.Value)
+	var priorObjs map[string]*unstructured.Unstructured
+	var priorInventoryID string
+	if !priorState.IsNull() {
+		priorState.As(&priorVal)
+		priorVal["inventory_id"].As(&priorInventoryID)
+		priorObjs, err = manifestsFromState(priorVal["manifests"])
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to decode the objects of the prior state",
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+	}
+
+	if plannedState.IsNull() {
+		if p := getDeletionPolicy(priorVal); p == deletionPolicyDelete {
+			keys := sortManifestsKeys(priorObjs)
+			for i := len(keys) - 1; i >= 0; i-- {
+				if err := s.deleteManifestsObject(ctx, priorObjs[keys[i]], priorInventoryID); err != nil {
+					resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  fmt.Sprintf("Failed to delete %s", keys[i]),
+						Detail:   err.Error(),
+					})
+				}
+			}
+			if len(resp.Diagnostics) == 0 {
+				resp.Diagnostics = append(resp.Diagnostics, s.deleteApplySet(ctx, priorVal, priorObjs)...)
+			}
+			if len(resp.Diagnostics) > 0 {
+				resp.NewState = req.PriorState
+				return resp, nil
+			}
+		} else {
+			s.logger.Info("[kubernetes_manifests] leaving objects in the cluster", "deletion_policy", p)
+		}
+		resp.NewState = req.PlannedState
+		return resp, nil
+	}
+
+	plannedVal := make(map[string]tftypes.Value)
+	if err := plannedState.As(&plannedVal); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to extract planned resource state from tftypes.Value",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	if !plannedVal["inventory_id"].IsKnown() {
+		plannedVal["inventory_id"] = tftypes.NewValue(tftypes.String, string(uuid.NewUUID()))
+	}
+	var inventoryID string
+	plannedVal["inventory_id"].As(&inventoryID)
+
+	var objs map[string]*unstructured.Unstructured
+	var diags []*tfprotov5.Diagnostic
+	if plannedVal["manifests"].IsKnown() {
+		objs, err = manifestsFromState(plannedVal["manifests"])
+		if err != nil {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to decode the planned objects",
+				Detail:   err.Error(),
+			})
+		}
+	} else {
+		// the bundle was not known during plan
+		objs, diags = s.manifestsFromConfig(plannedVal)
+	}
+	if len(diags) > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		return resp, nil
+	}
+
+	fieldManager, forceConflicts, err := s.getFieldManagerConfig(plannedVal)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Could not extract field_manager config",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	as, err := getApplySetConfig(plannedVal, "Terraform/"+s.hostTFVersion)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Could not extract applyset config",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	var asGroupKinds, asNamespaces sets.Set[string]
+	if as != nil {
+		// until the objects removed from the bundle are pruned, the parent lists the members of both applies
+		asGroupKinds, asNamespaces, err = s.fetchApplySetParent(ctx, as)
+		if err == nil {
+			gks, namespaces := as.members(objs)
+			asGroupKinds, asNamespaces = asGroupKinds.Union(gks), asNamespaces.Union(namespaces)
+			err = s.applyApplySetParent(ctx, as, asGroupKinds, asNamespaces, fieldManager, forceConflicts)
+		}
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to update the ApplySet parent",
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+	}
+
+	// the state records the objects applied so far, so that they are pruned or deleted later if the apply fails
+	applied := make(map[string]*unstructured.Unstructured, len(priorObjs)+len(objs))
+	for k, u := range priorObjs {
+		applied[k] = u
+	}
+	newState := func() (*tfprotov5.DynamicValue, error) {
+		plannedVal["manifests"], err = manifestsToValue(applied)
+		if err != nil {
+			return nil, err
+		}
+		ns := tftypes.NewValue(plannedState.Type(), plannedVal)
+		dv, err := tfprotov5.NewDynamicValue(ns.Type(), ns)
+		return &dv, err
+	}
+
+	for _, k := range sortManifestsKeys(objs) {
+		u := objs[k].DeepCopy()
+		labels := u.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[inventoryLabel] = inventoryID
+		if as != nil {
+			labels[applySetPartOfLabel] = as.ID()
+		}
+		u.SetLabels(labels)
+
+		err := func() error {
+			rs, err := s.manifestsResourceInterface(ctx, u, true)
+			if err != nil {
+				return err
+			}
+			body, err := u.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			s.logger.Trace("[kubernetes_manifests] applying", "object", k)
+			_, err = rs.Patch(ctx, u.GetName(), types.ApplyPatchType, body, metav1.PatchOptions{
+				FieldManager: fieldManager,
+				Force:        &forceConflicts,
+			})
+			return err
+		}()
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  fmt.Sprintf("Failed to apply %s", k),
+				Detail:   err.Error(),
+			})
+			resp.NewState, err = newState()
+			return resp, err
+		}
+		applied[k] = objs[k]
+	}
+
+	prune := true
+	if !plannedVal["prune"].IsNull() {
+		plannedVal["prune"].As(&prune)
+	}
+	stale := make(map[string]*unstructured.Unstructured)
+	for k, u := range priorObjs {
+		if _, ok := objs[k]; !ok {
+			stale[k] = u
+		}
+	}
+	keys := sortManifestsKeys(stale)
+	for i := len(keys) - 1; i >= 0; i-- {
+		k := keys[i]
+		if prune {
+			if err := s.deleteManifestsObject(ctx, stale[k], priorInventoryID); err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  fmt.Sprintf("Failed to prune %s", k),
+					Detail:   err.Error(),
+				})
+				continue
+			}
+		}
+		delete(applied, k)
+	}
+
+	if as != nil && prune {
+		err := s.pruneApplySet(ctx, as, asGroupKinds, asNamespaces, objs)
+		if err == nil {
+			gks, namespaces := as.members(objs)
+			err = s.applyApplySetParent(ctx, as, gks, namespaces, fieldManager, forceConflicts)
+		}
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to prune the ApplySet",
+				Detail:   err.Error(),
+			})
+		}
+	}
+	// the members were relabelled, so the parent of the previous ApplySet no longer has any
+	if priorAS, _ := getApplySetConfig(priorVal, ""); priorAS != nil && (as == nil || priorAS.ID() != as.ID()) {
+		if err := s.deleteApplySetParent(ctx, priorAS); err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to delete the previous ApplySet parent",
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	resp.NewState, err = newState()
+	return resp, err
+}
+
+func (s *RawProviderServer) readManifestsResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	resp := &tfprotov5.ReadResourceResponse{}
+	resp.Private = req.Private
+
+	cp := req.ClientCapabilities
+	if cp != nil && cp.DeferralAllowed && s.clientConfigUnknown {
+		resp.NewState = req.CurrentState
+		resp.Deferred = &tfprotov5.Deferred{
+			Reason: tfprotov5.DeferredReasonProviderConfigUnknown,
+		}
+		return resp, nil
+	}
+
+	execDiag := s.canExecute()
+	if len(execDiag) > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, execDiag...)
+		return resp, nil
+	}
+
+	rt, err := GetResourceType(req.TypeName)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to determine resource type",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	currentState, err := req.CurrentState.Unmarshal(rt)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to decode current state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	if currentState.IsNull() {
+		resp.NewState = req.CurrentState
+		return resp, nil
+	}
+	currentVal := make(map[string]tftypes.Value)
+	currentState.As(&currentVal)
+	objs, err := manifestsFromState(currentVal["manifests"])
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to decode the objects of the current state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	// objects deleted from the cluster are dropped from the state, so that they are planned to be applied again
+	for k, u := range objs {
+		rs, err := s.manifestsResourceInterface(ctx, u, false)
+		if err == nil {
+			_, err = rs.Get(ctx, u.GetName(), metav1.GetOptions{})
+		}
+		if err != nil {
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				delete(objs, k)
+				continue
+			}
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  fmt.Sprintf("Failed to read %s", k),
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+	}
+	currentVal["manifests"], err = manifestsToValue(objs)
+	if err != nil {
+		return resp, err
+	}
+	ns := tftypes.NewValue(currentState.Type(), currentVal)
+	nsv, err := tfprotov5.NewDynamicValue(ns.Type(), ns)
+	if err != nil {
+		return resp, err
+	}
+	resp.NewState = &nsv
+	return resp, nil
+}
+
+// deleteApplySet deletes the remaining members of the ApplySet of a destroyed resource, then its parent
+func (s *RawProviderServer) deleteApplySet(ctx context.Context, priorVal map[string]tftypes.Value, priorObjs map[string]*unstructured.Unstructured) []*tfprotov5.Diagnostic {
+	as, err := getApplySetConfig(priorVal, "Terraform/"+s.hostTFVersion)
+	if as == nil || err != nil {
+		return nil
+	}
+	gks, namespaces, err := s.fetchApplySetParent(ctx, as)
+	if err == nil {
+		members, memberNamespaces := as.members(priorObjs)
+		err = s.pruneApplySet(ctx, as, gks.Union(members), namespaces.Union(memberNamespaces), nil)
+	}
+	if err == nil {
+		err = s.deleteApplySetParent(ctx, as)
+	}
+	if err != nil {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to delete the ApplySet",
+			Detail:   err.Error(),
+		}}
+	}
+	return nil
+}
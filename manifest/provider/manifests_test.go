@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const testBundle = `apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: test
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: test
+    namespace: test
+---
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  group: example.com
+  scope: Namespaced
+  names:
+    kind: Widget
+    plural: widgets
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: test
+`
+
+func TestDecodeManifestsContent(t *testing.T) {
+	docs, err := decodeManifestsContent(testBundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs) != 4 {
+		t.Fatalf("expected 4 documents, got %d", len(docs))
+	}
+	docs = expandManifestsLists(docs)
+	if len(docs) != 4 || docs[1]["kind"] != "ConfigMap" {
+		t.Fatalf("expected the list to be replaced by its items, got %v", docs)
+	}
+	scopes := crdScopes(docs)
+	if namespaced, ok := scopes[schema.GroupKind{Group: "example.com", Kind: "Widget"}]; !ok || !namespaced {
+		t.Errorf("expected Widget to be namespaced, got %v", scopes)
+	}
+
+	if _, err := decodeManifestsContent("kind: [unclosed"); err == nil {
+		t.Error("expected invalid YAML to fail")
+	}
+}
+
+func TestDecodeManifestsObjects(t *testing.T) {
+	obj := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"apiVersion": tftypes.String,
+		"kind":       tftypes.String,
+	}}, map[string]tftypes.Value{
+		"apiVersion": tftypes.NewValue(tftypes.String, "v1"),
+		"kind":       tftypes.NewValue(tftypes.String, "ConfigMap"),
+	})
+	v := tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{obj.Type()}}, []tftypes.Value{obj})
+	docs, err := decodeManifestsObjects(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs) != 1 || docs[0]["kind"] != "ConfigMap" {
+		t.Errorf("unexpected objects: %v", docs)
+	}
+
+	if _, err := decodeManifestsObjects(obj); err == nil {
+		t.Error("expected a single object to fail")
+	}
+}
+
+func TestSortManifestsKeys(t *testing.T) {
+	objs := make(map[string]*unstructured.Unstructured)
+	for _, o := range []struct{ apiVersion, kind, namespace, name string }{
+		{"example.com/v1", "Widget", "test", "test"},
+		{"apps/v1", "Deployment", "test", "test"},
+		{"v1", "ConfigMap", "test", "b"},
+		{"v1", "ConfigMap", "test", "a"},
+		{"apiextensions.k8s.io/v1", "CustomResourceDefinition", "", "widgets.example.com"},
+		{"v1", "Namespace", "", "test"},
+	} {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(o.apiVersion)
+		u.SetKind(o.kind)
+		u.SetNamespace(o.namespace)
+		u.SetName(o.name)
+		objs[manifestsObjectKey(u)] = u
+	}
+	expected := []string{
+		"v1/Namespace/test",
+		"apiextensions.k8s.io/v1/CustomResourceDefinition/widgets.example.com",
+		"v1/ConfigMap/test/a",
+		"v1/ConfigMap/test/b",
+		"apps/v1/Deployment/test/test",
+		"example.com/v1/Widget/test/test",
+	}
+	if keys := sortManifestsKeys(objs); !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v, got %v", expected, keys)
+	}
+}
+
+func TestManifestsStateRoundTrip(t *testing.T) {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind("ConfigMap")
+	u.SetNamespace("test")
+	u.SetName("test")
+	objs := map[string]*unstructured.Unstructured{manifestsObjectKey(u): u}
+
+	v, err := manifestsToValue(objs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := manifestsFromState(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, objs) {
+		t.Errorf("expected %v, got %v", objs, out)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/csaupgrade"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	deletionPolicyDelete  = "delete"
+	deletionPolicyRetain  = "retain"
+	deletionPolicyAbandon = "abandon"
+)
+
+// releasedAnnotation records when Terraform stopped managing a retained resource
+const releasedAnnotation = "terraform.io/released-at"
+
+// getFieldManagerOwnershipConfig returns the managers whose fields are adopted by our field manager
+// and whether the ownership of the fields is released rather than the resource deleted on destroy
+func getFieldManagerOwnershipConfig(v map[string]tftypes.Value) ([]string, bool, error) {
+	var adoptFrom []string
+	var releaseOnDestroy bool
+	if v["field_manager"].IsNull() || !v["field_manager"].IsKnown() {
+		return adoptFrom, releaseOnDestroy, nil
+	}
+	var fieldManagerBlock []tftypes.Value
+	if err := v["field_manager"].As(&fieldManagerBlock); err != nil {
+		return nil, false, err
+	}
+	if len(fieldManagerBlock) == 0 {
+		return adoptFrom, releaseOnDestroy, nil
+	}
+	var fieldManagerObj map[string]tftypes.Value
+	if err := fieldManagerBlock[0].As(&fieldManagerObj); err != nil {
+		return nil, false, err
+	}
+	if am, ok := fieldManagerObj["adopt_from_managers"]; ok && !am.IsNull() && am.IsKnown() {
+		var managers []tftypes.Value
+		if err := am.As(&managers); err != nil {
+			return nil, false, err
+		}
+		for _, m := range managers {
+			if m.IsNull() || !m.IsKnown() {
+				continue
+			}
+			var name string
+			if err := m.As(&name); err != nil {
+				return nil, false, err
+			}
+			adoptFrom = append(adoptFrom, name)
+		}
+	}
+	if rd, ok := fieldManagerObj["release_on_destroy"]; ok && !rd.IsNull() && rd.IsKnown() {
+		if err := rd.As(&releaseOnDestroy); err != nil {
+			return nil, false, err
+		}
+	}
+	return adoptFrom, releaseOnDestroy, nil
+}
+
+// adoptManagedFields transfers the fields owned by the given managers through 'Update' operations
+// (as recorded by kubectl client-side apply, Helm and most controllers) to our field manager,
+// so that later server-side applies take over those fields instead of conflicting with them.
+// It is a no-op once the managers no longer own any fields of the resource.
+func (s *RawProviderServer) adoptManagedFields(ctx context.Context, rs dynamic.ResourceInterface, rname string, fieldManager string, managers []string) error {
+	live, err := rs.Get(ctx, rname, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(live, sets.New(managers...), fieldManager)
+	if err != nil || patch == nil {
+		return err
+	}
+	s.logger.Trace("[adoptManagedFields]", "patch", string(patch))
+	_, err = rs.Patch(ctx, rname, types.JSONPatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// releaseManagedFields gives up the ownership of all fields held by our field manager by removing
+// its entries from the managedFields of the resource, leaving the fields and their values in place.
+// Applying a configuration without the fields instead would make the API server remove them.
+func (s *RawProviderServer) releaseManagedFields(ctx context.Context, rs dynamic.ResourceInterface, obj *unstructured.Unstructured, fieldManager string) error {
+	return retry.OnError(retry.DefaultRetry, func(err error) bool {
+		// the test operations of the patch fail when the resource changed since it was read
+		return apierrors.IsConflict(err) || apierrors.IsInvalid(err)
+	}, func() error {
+		live, err := rs.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		patch, err := releaseManagedFieldsPatch(live, fieldManager)
+		if err != nil || patch == nil {
+			return err
+		}
+		s.logger.Trace("[releaseManagedFields]", "patch", string(patch))
+		_, err = rs.Patch(ctx, obj.GetName(), types.JSONPatchType, patch, metav1.PatchOptions{})
+		return err
+	})
+}
+
+// releaseManagedFieldsPatch returns a JSON patch removing the managedFields entries of a field manager,
+// guarded by the current resourceVersion and managedFields of the resource, or nil when it owns no fields.
+func releaseManagedFieldsPatch(live *unstructured.Unstructured, fieldManager string) ([]byte, error) {
+	current, _, err := unstructured.NestedSlice(live.Object, "metadata", "managedFields")
+	if err != nil {
+		return nil, err
+	}
+	kept := []interface{}{}
+	for _, e := range current {
+		if m, ok := e.(map[string]interface{}); ok && m["manager"] == fieldManager {
+			continue
+		}
+		kept = append(kept, e)
+	}
+	if len(kept) == len(current) {
+		return nil, nil
+	}
+	if len(kept) == 0 {
+		// an empty list leaves managedFields unchanged, a single empty entry clears it
+		kept = []interface{}{map[string]interface{}{}}
+	}
+	return json.Marshal([]map[string]interface{}{
+		{"op": "test", "path": "/metadata/resourceVersion", "value": live.GetResourceVersion()},
+		{"op": "test", "path": "/metadata/managedFields", "value": current},
+		{"op": "replace", "path": "/metadata/managedFields", "value": kept},
+	})
+}
+
+// markReleased annotates a resource left in the cluster on destroy with the time Terraform released it
+func (s *RawProviderServer) markReleased(ctx context.Context, rs dynamic.ResourceInterface, rname string, fieldManager string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				releasedAnnotation: time.Now().UTC().Format(time.RFC3339),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = rs.Patch(ctx, rname, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: fieldManager})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
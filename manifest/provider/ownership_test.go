@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestReleaseManagedFields(t *testing.T) {
+	entry := func(manager, operation string) interface{} {
+		return map[string]interface{}{
+			"manager":    manager,
+			"operation":  operation,
+			"apiVersion": "v1",
+			"fieldsType": "FieldsV1",
+			"fieldsV1":   map[string]interface{}{"f:data": map[string]interface{}{"f:foo": map[string]interface{}{}}},
+		}
+	}
+	samples := map[string]struct {
+		managedFields []interface{}
+		expected      []string
+	}{
+		"shared":      {[]interface{}{entry("Terraform", "Apply"), entry("kubectl", "Update")}, []string{"kubectl"}},
+		"sole owner":  {[]interface{}{entry("Terraform", "Apply"), entry("Terraform", "Update")}, []string{}},
+		"not managed": {[]interface{}{entry("kubectl", "Update")}, []string{"kubectl"}},
+	}
+
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	for name, sample := range samples {
+		t.Run(name, func(t *testing.T) {
+			cm := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"name":            "test",
+					"namespace":       "default",
+					"resourceVersion": "1",
+					"managedFields":   sample.managedFields,
+				},
+				"data": map[string]interface{}{"foo": "bar"},
+			}}
+			c := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), cm)
+			rs := c.Resource(gvr).Namespace("default")
+			s := &RawProviderServer{logger: hclog.NewNullLogger()}
+
+			if err := s.releaseManagedFields(context.Background(), rs, cm, "Terraform"); err != nil {
+				t.Fatal(err)
+			}
+
+			out, err := rs.Get(context.Background(), "test", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v, _, _ := unstructured.NestedString(out.Object, "data", "foo"); v != "bar" {
+				t.Fatalf("expected data.foo to be left in place, got %q", v)
+			}
+			managers := []string{}
+			for _, e := range out.GetManagedFields() {
+				if e.Manager != "" {
+					managers = append(managers, e.Manager)
+				}
+			}
+			if len(managers) != len(sample.expected) || (len(managers) > 0 && managers[0] != sample.expected[0]) {
+				t.Fatalf("expected managers %v, got %v", sample.expected, managers)
+			}
+		})
+	}
+}
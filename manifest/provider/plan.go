@@ -128,6 +128,9 @@ func isImportedFlagFromPrivate(p []byte) (f bool, d []*tfprotov5.Diagnostic) {
 
 // PlanResourceChange function
 func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	if req.TypeName == manifestsResourceType {
+		return s.planManifestsResource(ctx, req)
+	}
 	resp := &tfprotov5.PlanResourceChangeResponse{}
 
 	rt, err := GetResourceType(req.TypeName)
@@ -164,7 +167,10 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 
 	canDeferr := req.ClientCapabilities != nil && req.ClientCapabilities.DeferralAllowed
 
-	if canDeferr && s.clientConfigUnknown {
+	// resources fully described by the "schema_sources" provider attribute can be planned without the API server
+	offline := s.isResolvableOffline(proposedVal["manifest"])
+
+	if canDeferr && s.clientConfigUnknown && !offline {
 		// if client supports it, request deferral when client configuration not fully known
 		proposedVal["object"] = tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue)
 		newPlannedState := tftypes.NewValue(proposedState.Type(), proposedVal)
@@ -198,9 +204,11 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 	}
 
 	// test if credentials are valid - we're going to need them further down
-	resp.Diagnostics = append(resp.Diagnostics, s.checkValidCredentials(ctx)...)
-	if len(resp.Diagnostics) > 0 {
-		return resp, nil
+	if !offline {
+		resp.Diagnostics = append(resp.Diagnostics, s.checkValidCredentials(ctx)...)
+		if len(resp.Diagnostics) > 0 {
+			return resp, nil
+		}
 	}
 
 	computedFields := make(map[string]*tftypes.AttributePath)
@@ -237,6 +245,12 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 		computedFields[atp.String()] = atp
 	}
 
+	ignoreFields, ifDiags := getIgnoreFields(proposedVal)
+	if len(ifDiags) > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, ifDiags...)
+		return resp, nil
+	}
+
 	// Decode prior resource state
 	priorState, err := req.PriorState.Unmarshal(rt)
 	if err != nil {
@@ -392,6 +406,13 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 	}
 	s.logger.Debug("[PlanResourceChange]", "morphed manifest", dump(morphedManifest))
 
+	// Surface violations of the CRD validation rules at plan time, pointing at the offending attributes
+	sd := s.validateManifestSchema(ctx, gvk, ppMan, morphedManifest, priorVal["object"], hints)
+	if len(sd) > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, sd...)
+		return resp, nil
+	}
+
 	completePropMan, err := morph.DeepUnknown(objectType, morphedManifest, tftypes.NewAttributePath().WithAttributeName("object"))
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
@@ -409,7 +430,8 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 		s.logger.Debug("[PlanResourceChange]", "creating object", dump(completePropMan))
 		newObj, err := tftypes.Transform(completePropMan, func(ap *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
 			_, ok := computedFields[ap.String()]
-			if ok {
+			if ok || hasPathPrefix(ap, ignoreFields) {
+				// ignored fields are left for other managers to set
 				return tftypes.NewValue(v.Type(), tftypes.UnknownValue), nil
 			}
 			return v, nil
@@ -446,7 +468,40 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 			})
 			return resp, nil
 		}
+		if policy := getDriftPolicy(proposedVal); policy != driftPolicyIgnore && !offline && !priorObj.IsNull() {
+			fieldManagerName, _, err := s.getFieldManagerConfig(proposedVal)
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Could not extract field_manager config",
+					Detail:   err.Error(),
+				})
+				return resp, nil
+			}
+			skipFields := make(map[string]*tftypes.AttributePath, len(computedFields)+len(ignoreFields))
+			for k, v := range computedFields {
+				skipFields[k] = v
+			}
+			for k, v := range ignoreFields {
+				skipFields[k] = v
+			}
+			dd := s.detectDrift(ctx, policy, gvk, ppMan, priorMan, morphedManifest, priorObj, fieldManagerName, ns, skipFields)
+			resp.Diagnostics = append(resp.Diagnostics, dd...)
+			for _, d := range dd {
+				if d.Severity == tfprotov5.DiagnosticSeverityError {
+					return resp, nil
+				}
+			}
+		}
 		updatedObj, err := tftypes.Transform(completePropMan, func(ap *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+			if hasPathPrefix(ap, ignoreFields) {
+				// ignored fields keep whatever value other managers gave them
+				priorAtrVal, restPath, err := tftypes.WalkAttributePath(priorObj, ap)
+				if err == nil && len(restPath.Steps()) == 0 && priorAtrVal.(tftypes.Value).Type().Equal(v.Type()) {
+					return priorAtrVal.(tftypes.Value), nil
+				}
+				return tftypes.NewValue(v.Type(), tftypes.UnknownValue), nil
+			}
 			_, isComputed := computedFields[ap.String()]
 			if v.IsKnown() { // this is a value from current configuration - include it in the plan
 				hasChanged := false
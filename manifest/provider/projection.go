@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest/payload"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// projection extracts a value from an object, with either a JSONPath or a CEL expression
+type projection interface {
+	project(obj map[string]interface{}) (interface{}, error)
+}
+
+// jsonPathExpression matches JSONPath expressions, written as in kubectl's "-o jsonpath" or
+// "-o custom-columns" (e.g. "{.metadata.name}" or ".metadata.name"), rather than CEL
+// expressions, which may also start with a brace as map literals do
+var jsonPathExpression = regexp.MustCompile(`^\s*(\{\s*(\.|\$|range\s)|\.|\$)`)
+
+func isJSONPathExpression(expr string) bool {
+	return jsonPathExpression.MatchString(expr)
+}
+
+// compileProjection parses a JSONPath or a CEL expression. CEL expressions access the object as "self".
+func compileProjection(name, expr string) (projection, error) {
+	if isJSONPathExpression(expr) {
+		tmpl := strings.TrimSpace(expr)
+		if !strings.HasPrefix(tmpl, "{") {
+			tmpl = "{" + tmpl + "}"
+		}
+		jp := jsonpath.New(name).AllowMissingKeys(true)
+		if err := jp.Parse(tmpl); err != nil {
+			return nil, fmt.Errorf("invalid JSONPath expression %q: %s", expr, err)
+		}
+		return &jsonPathProjection{path: jp}, nil
+	}
+	env, err := cel.NewEnv(cel.Variable("self", cel.DynType))
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("invalid expression %q: %s", expr, iss.Err())
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	return &celProjection{program: prg}, nil
+}
+
+type jsonPathProjection struct {
+	path *jsonpath.JSONPath
+}
+
+// project returns the value matched by the expression, a list when it matches
+// more than one value, or nil when it matches none
+func (p *jsonPathProjection) project(obj map[string]interface{}) (interface{}, error) {
+	results, err := p.path.FindResults(obj)
+	if err != nil {
+		return nil, err
+	}
+	var values []interface{}
+	for _, r := range results {
+		for _, v := range r {
+			values = append(values, v.Interface())
+		}
+	}
+	switch len(values) {
+	case 0:
+		return nil, nil
+	case 1:
+		return values[0], nil
+	}
+	return values, nil
+}
+
+type celProjection struct {
+	program cel.Program
+}
+
+// project returns the result of the expression as a JSON compatible value
+func (p *celProjection) project(obj map[string]interface{}) (interface{}, error) {
+	out, _, err := p.program.Eval(map[string]interface{}{"self": obj})
+	if err != nil {
+		return nil, err
+	}
+	pv, err := out.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert %s to a Terraform value: %s", out.Type(), err)
+	}
+	js, err := protojson.Marshal(pv.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	err = json.Unmarshal(js, &v)
+	return v, err
+}
+
+// compileProjections compiles the expressions of the "select" attribute
+func compileProjections(exprs map[string]string) (map[string]projection, error) {
+	projections := make(map[string]projection, len(exprs))
+	for name, expr := range exprs {
+		p, err := compileProjection(name, expr)
+		if err != nil {
+			return nil, fmt.Errorf("select[%q]: %s", name, err)
+		}
+		projections[name] = p
+	}
+	return projections, nil
+}
+
+// projectObject returns an object holding the value of each projection for obj
+func projectObject(obj map[string]interface{}, projections map[string]projection, at *tftypes.AttributePath) (tftypes.Value, error) {
+	names := make([]string, 0, len(projections))
+	for name := range projections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make(map[string]tftypes.Value, len(projections))
+	types := make(map[string]tftypes.Type, len(projections))
+	for _, name := range names {
+		v, err := projections[name].project(obj)
+		if err != nil {
+			return tftypes.Value{}, at.NewErrorf("[%s] cannot evaluate select[%q]: %s", at.String(), name, err)
+		}
+		var tv tftypes.Value
+		if v == nil {
+			// the type of a null value must be known in a dynamic value
+			tv = tftypes.NewValue(tftypes.String, nil)
+		} else {
+			tv, err = payload.ToTFValue(v, tftypes.DynamicPseudoType, map[string]string{}, at.WithAttributeName(name))
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+		}
+		values[name] = tv
+		types[name] = tv.Type()
+	}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: types}, values), nil
+}
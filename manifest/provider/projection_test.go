@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testNode(name string, ready string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Node",
+		"metadata": map[string]interface{}{
+			"name": name,
+		},
+		"status": map[string]interface{}{
+			"addresses": []interface{}{
+				map[string]interface{}{"type": "InternalIP", "address": "10.0.0.1"},
+				map[string]interface{}{"type": "Hostname", "address": name},
+			},
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": ready},
+			},
+			"capacity": map[string]interface{}{
+				"pods": int64(110),
+			},
+		},
+	}
+}
+
+func TestCompileProjection(t *testing.T) {
+	node := testNode("node-1", "True")
+	samples := []struct {
+		expr     string
+		expected interface{}
+	}{
+		{`{.metadata.name}`, "node-1"},
+		{`.metadata.name`, "node-1"},
+		{`{.status.addresses[?(@.type=="InternalIP")].address}`, "10.0.0.1"},
+		{`{.status.addresses[*].type}`, []interface{}{"InternalIP", "Hostname"}},
+		{`{.metadata.labels}`, nil},
+		{`self.metadata.name`, "node-1"},
+		{`self.status.addresses.filter(a, a.type == "InternalIP").map(a, a.address)`, []interface{}{"10.0.0.1"}},
+		{`self.status.capacity.pods`, float64(110)},
+		{`{"name": self.metadata.name}`, map[string]interface{}{"name": "node-1"}},
+	}
+	for _, s := range samples {
+		t.Run(s.expr, func(t *testing.T) {
+			p, err := compileProjection("test", s.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			v, err := p.project(node)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(v, s.expected) {
+				t.Errorf("expected %#v, got %#v", s.expected, v)
+			}
+		})
+	}
+
+	for _, expr := range []string{`{.metadata.name`, `self.metadata.`} {
+		if _, err := compileProjection("test", expr); err == nil {
+			t.Errorf("expected %q to be invalid", expr)
+		}
+	}
+}
+
+func TestProjectObject(t *testing.T) {
+	projections, err := compileProjections(map[string]string{
+		"name":   "{.metadata.name}",
+		"ip":     `{.status.addresses[?(@.type=="InternalIP")].address}`,
+		"pods":   "self.status.capacity.pods",
+		"labels": "{.metadata.labels}",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := projectObject(testNode("node-1", "True"), projections, tftypes.NewAttributePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":   tftypes.String,
+		"ip":     tftypes.String,
+		"pods":   tftypes.Number,
+		"labels": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"name":   tftypes.NewValue(tftypes.String, "node-1"),
+		"ip":     tftypes.NewValue(tftypes.String, "10.0.0.1"),
+		"pods":   tftypes.NewValue(tftypes.Number, big.NewFloat(110)),
+		"labels": tftypes.NewValue(tftypes.String, nil),
+	})
+	if !v.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, v)
+	}
+}
+
+func TestFilterObjects(t *testing.T) {
+	items := []map[string]interface{}{
+		testNode("node-1", "True"),
+		testNode("node-2", "False"),
+		testNode("node-3", "True"),
+	}
+	filtered, err := filterObjects(items, `self.status.conditions.exists(c, c.type == "Ready" && c.status == "True")`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filtered) != 2 {
+		t.Errorf("expected 2 objects, got %d", len(filtered))
+	}
+
+	if _, err := filterObjects(items, `self.spec.unschedulable`); err == nil {
+		t.Errorf("expected an error for a field missing from the objects")
+	}
+	if f, _ := filterObjects(items, ""); len(f) != len(items) {
+		t.Errorf("expected all objects without a filter")
+	}
+}
@@ -128,6 +128,28 @@ func GetProviderResourceSchema() map[string]*tfprotov5.Schema {
 									DescriptionKind: 0,
 									Deprecated:      false,
 								},
+								{
+									Name:            "adopt_from_managers",
+									Type:            tftypes.List{ElementType: tftypes.String},
+									Required:        false,
+									Optional:        true,
+									Computed:        false,
+									Sensitive:       false,
+									Description:     "Names of field managers, such as \"kubectl-client-side-apply\" or \"helm\", whose fields are transferred to this field manager before applying changes to an existing resource.",
+									DescriptionKind: 0,
+									Deprecated:      false,
+								},
+								{
+									Name:            "release_on_destroy",
+									Type:            tftypes.Bool,
+									Required:        false,
+									Optional:        true,
+									Computed:        false,
+									Sensitive:       false,
+									Description:     "On destroy, give up the ownership of the fields managed by this field manager instead of deleting the resource.",
+									DescriptionKind: 0,
+									Deprecated:      false,
+								},
 							},
 						},
 					},
@@ -159,6 +181,32 @@ func GetProviderResourceSchema() map[string]*tfprotov5.Schema {
 										},
 									},
 								},
+								{
+									TypeName: "failure_condition",
+									Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+									MinItems: 0,
+									Block: &tfprotov5.SchemaBlock{
+										Description: "A condition which, when present on the resource, aborts waiting with an error.",
+										Attributes: []*tfprotov5.SchemaAttribute{
+											{
+												Name:        "status",
+												Type:        tftypes.String,
+												Optional:    true,
+												Description: "The condition status.",
+											}, {
+												Name:        "type",
+												Type:        tftypes.String,
+												Required:    true,
+												Description: "The type of condition.",
+											}, {
+												Name:        "reason",
+												Type:        tftypes.String,
+												Optional:    true,
+												Description: "The condition reason.",
+											},
+										},
+									},
+								},
 							},
 							Attributes: []*tfprotov5.SchemaAttribute{
 								{
@@ -167,12 +215,30 @@ func GetProviderResourceSchema() map[string]*tfprotov5.Schema {
 									Optional:    true,
 									Description: "Wait for rollout to complete on resources that support `kubectl rollout status`.",
 								},
+								{
+									Name:        "ready",
+									Type:        tftypes.Bool,
+									Optional:    true,
+									Description: "Wait for the resource to become ready using the generic readiness rules of kstatus, based on `status.observedGeneration` and the `Ready`, `Reconciling` and `Stalled` conditions.",
+								},
 								{
 									Name:        "fields",
 									Type:        tftypes.Map{ElementType: tftypes.String},
 									Optional:    true,
 									Description: "A map of paths to fields to wait for a specific field value.",
 								},
+								{
+									Name:        "failure_fields",
+									Type:        tftypes.Map{ElementType: tftypes.String},
+									Optional:    true,
+									Description: "A map of paths to fields which, when any of them matches the given value, aborts waiting with an error.",
+								},
+								{
+									Name:        "expression",
+									Type:        tftypes.String,
+									Optional:    true,
+									Description: "A CEL expression evaluated against the resource, available as `self`, which must return true for the wait to complete.",
+								},
 							},
 						},
 					},
@@ -204,6 +270,36 @@ func GetProviderResourceSchema() map[string]*tfprotov5.Schema {
 						Deprecated:  true,
 						Description: "A map of attribute paths and desired patterns to be matched. After each apply the provider will wait for all attributes listed here to reach a value that matches the desired pattern.",
 					},
+					{
+						Name:        "delete_propagation",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "The propagation policy to use when deleting the resource. One of \"Foreground\", \"Background\" or \"Orphan\". Defaults to the policy of the resource type.",
+					},
+					{
+						Name:        "deletion_policy",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "What happens to the object in the cluster when the resource is destroyed. One of \"delete\", \"retain\" or \"abandon\". With \"retain\" the object is left in the cluster and annotated as released by Terraform, with \"abandon\" it is left untouched. Defaults to \"delete\".",
+					},
+					{
+						Name:        "remove_finalizers_on_timeout",
+						Type:        tftypes.Bool,
+						Optional:    true,
+						Description: "Remove all finalizers from the resource if it has not been deleted when the delete timeout expires. Use with caution: this skips any cleanup the finalizers were guarding.",
+					},
+					{
+						Name:        "drift_policy",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "How to report fields set in the manifest that were changed or taken over by other field managers since the last apply. One of \"ignore\", \"warn\" or \"error\". Defaults to \"ignore\".",
+					},
+					{
+						Name:        "ignore_fields",
+						Type:        tftypes.List{ElementType: tftypes.String},
+						Optional:    true,
+						Description: "List of manifest fields left to other field managers. These fields are never planned for change and are omitted from the server-side apply payload, even when set in the manifest.",
+					},
 					{
 						Name:        "computed_fields",
 						Type:        tftypes.List{ElementType: tftypes.String},
@@ -213,6 +309,110 @@ func GetProviderResourceSchema() map[string]*tfprotov5.Schema {
 				},
 			},
 		},
+		"kubernetes_manifests": {
+			Version: 0,
+			Block: &tfprotov5.SchemaBlock{
+				BlockTypes: []*tfprotov5.SchemaNestedBlock{
+					{
+						TypeName: "field_manager",
+						Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+						MinItems: 0,
+						MaxItems: 1,
+						Block: &tfprotov5.SchemaBlock{
+							Description: "Configure field manager options.",
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name:        "name",
+									Type:        tftypes.String,
+									Optional:    true,
+									Description: "The name to use for the field manager when creating and updating the objects.",
+								},
+								{
+									Name:        "force_conflicts",
+									Type:        tftypes.Bool,
+									Optional:    true,
+									Description: "Force changes against conflicts.",
+								},
+							},
+						},
+					},
+					{
+						TypeName: "applyset",
+						Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+						MinItems: 0,
+						MaxItems: 1,
+						Block: &tfprotov5.SchemaBlock{
+							Description: "Track the objects of the bundle as an ApplySet (KEP-3659), recorded on a parent Secret or ConfigMap.",
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name:        "name",
+									Type:        tftypes.String,
+									Required:    true,
+									Description: "Name of the parent object.",
+								},
+								{
+									Name:        "namespace",
+									Type:        tftypes.String,
+									Required:    true,
+									Description: "Namespace of the parent object.",
+								},
+								{
+									Name:        "kind",
+									Type:        tftypes.String,
+									Optional:    true,
+									Description: "Kind of the parent object. One of \"Secret\" or \"ConfigMap\". Defaults to \"Secret\".",
+								},
+								{
+									Name:        "tooling",
+									Type:        tftypes.String,
+									Optional:    true,
+									Description: "Value of the \"applyset.kubernetes.io/tooling\" annotation of the parent object, in the form \"<name>/<version>\". Only the tool named there may change the ApplySet. Defaults to \"Terraform/<Terraform version>\".",
+								},
+							},
+						},
+					},
+				},
+				Attributes: []*tfprotov5.SchemaAttribute{
+					{
+						Name:        "content",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "A stream of YAML or JSON documents separated by \"---\", each describing a Kubernetes object. Conflicts with \"objects\".",
+					},
+					{
+						Name:        "objects",
+						Type:        tftypes.DynamicPseudoType,
+						Optional:    true,
+						Description: "A list of Kubernetes objects, as returned by the \"manifest_decode_multi\" function. Conflicts with \"content\".",
+					},
+					{
+						Name:        "inventory_id",
+						Type:        tftypes.String,
+						Optional:    true,
+						Computed:    true,
+						Description: "Value of the \"terraform.io/inventory-id\" label set on every object of the bundle. Only objects carrying this label are pruned or deleted. Generated when not set.",
+					},
+					{
+						Name:        "prune",
+						Type:        tftypes.Bool,
+						Optional:    true,
+						Description: "Delete the objects removed from the bundle. Defaults to true.",
+					},
+					{
+						Name:        "deletion_policy",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "What happens to the objects in the cluster when the resource is destroyed. One of \"delete\", \"retain\" or \"abandon\". With \"retain\" or \"abandon\" the objects are left in the cluster. Defaults to \"delete\".",
+					},
+					{
+						Name:        "manifests",
+						Type:        tftypes.Map{ElementType: tftypes.String},
+						Computed:    true,
+						Description: "The objects of the bundle encoded as JSON, keyed by \"<apiVersion>/<kind>/[<namespace>/]<name>\".",
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -270,6 +470,79 @@ func GetProviderDataSourceSchema() map[string]*tfprotov5.Schema {
 				},
 			},
 		},
+		"kubernetes_resource_table": {
+			Version: 0,
+			Block: &tfprotov5.SchemaBlock{
+				Attributes: []*tfprotov5.SchemaAttribute{
+					{
+						Name:        "api_version",
+						Type:        tftypes.String,
+						Required:    true,
+						Description: "The resource apiVersion.",
+					},
+					{
+						Name:        "kind",
+						Type:        tftypes.String,
+						Required:    true,
+						Description: "The resource kind.",
+					},
+					{
+						Name:        "namespace",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "The resource namespace.",
+					},
+					{
+						Name:        "all_namespaces",
+						Type:        tftypes.Bool,
+						Optional:    true,
+						Description: "List the objects of all namespaces. Conflicts with \"namespace\".",
+					},
+					{
+						Name:        "field_selector",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "A selector to restrict the list of returned objects by their fields.",
+					},
+					{
+						Name:        "label_selector",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "A selector to restrict the list of returned objects by their labels.",
+					},
+					{
+						Name:        "limit",
+						Type:        tftypes.Number,
+						Optional:    true,
+						Description: "Limit is a maximum number of rows to return.",
+					},
+					{
+						Name:        "page_size",
+						Type:        tftypes.Number,
+						Optional:    true,
+						Description: "The number of rows requested from the API server at a time. All pages are read until \"limit\" rows are returned. Defaults to 500.",
+					},
+					{
+						Name:        "wide",
+						Type:        tftypes.Bool,
+						Optional:    true,
+						Description: "Include the columns with a priority greater than 0, as \"kubectl get -o wide\" does.",
+					},
+					{
+						Name:        "columns",
+						Type:        tftypes.List{ElementType: tableColumnType},
+						Computed:    true,
+						Description: "The columns defined by the API server for the resource, including the additionalPrinterColumns of custom resources.",
+					},
+					{
+						Name:        "rows",
+						Type:        tftypes.List{ElementType: tableRowType},
+						Computed:    true,
+						Description: "The rows of the table. The cells of each row are keyed by the name of their column.",
+					},
+				},
+			},
+		},
 		"kubernetes_resources": {
 			Version: 1,
 			Block: &tfprotov5.SchemaBlock{
@@ -317,6 +590,55 @@ func GetProviderDataSourceSchema() map[string]*tfprotov5.Schema {
 						Optional:    true,
 						Description: "Limit is a maximum number of responses to return for a list call.",
 					},
+					{
+						Name:        "page_size",
+						Type:        tftypes.Number,
+						Optional:    true,
+						Description: "The number of objects requested from the API server at a time. All pages are read until \"limit\" objects are returned. Defaults to 500.",
+					},
+					{
+						Name:        "all_namespaces",
+						Type:        tftypes.Bool,
+						Optional:    true,
+						Description: "List the objects of all namespaces. Conflicts with \"namespace\".",
+					},
+					{
+						Name:        "metadata_only",
+						Type:        tftypes.Bool,
+						Optional:    true,
+						Description: "Only return the apiVersion, kind and metadata of the objects, as PartialObjectMetadata.",
+					},
+					{
+						Name:        "resource_version",
+						Type:        tftypes.String,
+						Optional:    true,
+						Computed:    true,
+						Description: "The resourceVersion the list is served at. Set to the resourceVersion of the list when not configured.",
+					},
+					{
+						Name:        "resource_version_match",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "How \"resource_version\" is applied to the list call. One of \"Exact\" or \"NotOlderThan\". Requires \"resource_version\".",
+					},
+					{
+						Name:        "filter",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "A CEL expression evaluated against each object, available as \"self\". Only the objects for which it is true are returned.",
+					},
+					{
+						Name:        "select",
+						Type:        tftypes.Map{ElementType: tftypes.String},
+						Optional:    true,
+						Description: "A map of names to JSONPath (e.g. \"{.metadata.name}\") or CEL (e.g. \"self.metadata.name\") expressions evaluated against each object. When set, \"items\" holds the values of the expressions for each object instead of \"objects\" holding the objects.",
+					},
+					{
+						Name:        "items",
+						Type:        tftypes.DynamicPseudoType,
+						Computed:    true,
+						Description: "The values of the \"select\" expressions for each object.",
+					},
 				},
 			},
 		},
@@ -200,6 +200,50 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 				DescriptionKind: 0,
 				Deprecated:      false,
 			},
+			{
+				Name:            "apply_mode",
+				Type:            tftypes.String,
+				Description:     "How `kubernetes_config_map_v1`, `kubernetes_daemon_set_v1`, `kubernetes_deployment_v1`, `kubernetes_pod_template_v1`, `kubernetes_replica_set_v1`, `kubernetes_service_v1` and `kubernetes_stateful_set_v1` are created and updated. With \"server_side\" the objects are applied with server-side apply, so that fields set by other field managers are left untouched. Other resources ignore it. Defaults to \"client_side\".",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "force_conflicts",
+				Type:            tftypes.Bool,
+				Description:     "Force the server-side apply of typed resources when another field manager owns a field Terraform sets. Only used when `apply_mode` is \"server_side\".",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "cache_dir",
+				Type:            tftypes.String,
+				Description:     "Path to a directory in which to persist discovery data and OpenAPI documents between runs, using the same layout as kubectl's `~/.kube/cache`. Can be set with KUBE_CACHE_DIR environment variable.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "schema_sources",
+				Type:            tftypes.List{ElementType: tftypes.String},
+				Description:     "List of files, directories or glob patterns of CustomResourceDefinition manifests and OpenAPI v3 documents used to resolve resource types before consulting the API server. Allows planning `kubernetes_manifest` resources whose types are not yet known to the cluster.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
 		},
 		BlockTypes: []*tfprotov5.SchemaNestedBlock{
 			{
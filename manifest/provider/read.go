@@ -18,6 +18,9 @@ import (
 
 // ReadResource function
 func (s *RawProviderServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	if req.TypeName == manifestsResourceType {
+		return s.readManifestsResource(ctx, req)
+	}
 	resp := &tfprotov5.ReadResourceResponse{}
 
 	cp := req.ClientCapabilities
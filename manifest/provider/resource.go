@@ -86,34 +86,44 @@ func (ps *RawProviderServer) TFTypeFromOpenAPI(ctx context.Context, gvk schema.G
 	var tsch tftypes.Type
 	var hints map[string]string
 
-	oapi, err := ps.getOAPIv2Foundry()
-	if err != nil {
-		return nil, hints, fmt.Errorf("cannot get OpenAPI foundry: %s", err)
-	}
-	// check if GVK is from a CRD
-	crdSchema, err := ps.lookUpGVKinCRDs(ctx, gvk)
-	if err != nil {
-		return nil, hints, fmt.Errorf("failed to look up GVK [%s] among available CRDs: %s", gvk.String(), err)
-	}
-	if crdSchema != nil {
-		js, err := json.Marshal(openapi.SchemaToSpec("", crdSchema.(map[string]interface{})))
+	// types described by the local schema sources take precedence over the API server
+	if lf, ok := ps.localSchemas.foundryFor(gvk); ok {
+		var err error
+		tsch, hints, err = lf.GetTypeByGVK(gvk)
 		if err != nil {
-			return nil, hints, fmt.Errorf("CRD schema fails to marshal into JSON: %s", err)
+			return nil, hints, fmt.Errorf("failed to generate tftypes for GVK [%s] from schema sources: %s", gvk.String(), err)
 		}
-		oapiv3, err := openapi.NewFoundryFromSpecV3(js)
+	}
+	if tsch == nil {
+		oapi, err := ps.getOAPIFoundry()
 		if err != nil {
-			return nil, hints, err
+			return nil, hints, fmt.Errorf("cannot get OpenAPI foundry: %s", err)
 		}
-		tsch, hints, err = oapiv3.GetTypeByGVK(gvk)
+		// check if GVK is from a CRD
+		crdSchema, err := ps.lookUpGVKinCRDs(ctx, gvk)
 		if err != nil {
-			return nil, hints, fmt.Errorf("failed to generate tftypes for GVK [%s] from CRD schema: %s", gvk.String(), err)
+			return nil, hints, fmt.Errorf("failed to look up GVK [%s] among available CRDs: %s", gvk.String(), err)
 		}
-	}
-	if tsch == nil {
-		// Not a CRD type - look GVK up in cluster OpenAPI spec
-		tsch, hints, err = oapi.GetTypeByGVK(gvk)
-		if err != nil {
-			return nil, hints, fmt.Errorf("cannot get resource type from OpenAPI (%s): %s", gvk.String(), err)
+		if crdSchema != nil {
+			js, err := json.Marshal(openapi.SchemaToSpec("", crdSchema.(map[string]interface{})))
+			if err != nil {
+				return nil, hints, fmt.Errorf("CRD schema fails to marshal into JSON: %s", err)
+			}
+			oapiv3, err := openapi.NewFoundryFromSpecV3(js)
+			if err != nil {
+				return nil, hints, err
+			}
+			tsch, hints, err = oapiv3.GetTypeByGVK(gvk)
+			if err != nil {
+				return nil, hints, fmt.Errorf("failed to generate tftypes for GVK [%s] from CRD schema: %s", gvk.String(), err)
+			}
+		}
+		if tsch == nil {
+			// Not a CRD type - look GVK up in cluster OpenAPI spec
+			tsch, hints, err = oapi.GetTypeByGVK(gvk)
+			if err != nil {
+				return nil, hints, fmt.Errorf("cannot get resource type from OpenAPI (%s): %s", gvk.String(), err)
+			}
 		}
 	}
 	// remove "status" attribute from resource type
@@ -133,7 +143,7 @@ func (ps *RawProviderServer) TFTypeFromOpenAPI(ctx context.Context, gvk schema.G
 		if _, ok := atts["kind"]; !ok {
 			atts["kind"] = tftypes.String
 		}
-		metaType, _, err := oapi.GetTypeByGVK(openapi.ObjectMetaGVK)
+		metaType, err := ps.objectMetaType()
 		if err != nil {
 			return nil, hints, fmt.Errorf("failed to generate tftypes for v1.ObjectMeta: %s", err)
 		}
@@ -145,6 +155,20 @@ func (ps *RawProviderServer) TFTypeFromOpenAPI(ctx context.Context, gvk schema.G
 	return tsch, hints, nil
 }
 
+// objectMetaType returns the tftypes.Type of v1.ObjectMeta, preferably from the local schema sources
+func (ps *RawProviderServer) objectMetaType() (tftypes.Type, error) {
+	oapi, ok := ps.localSchemas.foundryFor(openapi.ObjectMetaGVK)
+	if !ok {
+		var err error
+		oapi, err = ps.getOAPIFoundry()
+		if err != nil {
+			return nil, fmt.Errorf("cannot get OpenAPI foundry: %s", err)
+		}
+	}
+	t, _, err := oapi.GetTypeByGVK(openapi.ObjectMetaGVK)
+	return t, err
+}
+
 func mapRemoveNulls(in map[string]interface{}) map[string]interface{} {
 	for k, v := range in {
 		switch tv := v.(type) {
@@ -201,26 +225,82 @@ func RemoveServerSideFields(in map[string]interface{}) map[string]interface{} {
 	return in
 }
 
+// builtInAPIGroups are the API groups served by kube-apiserver itself, whose types cannot come from a CRD
+var builtInAPIGroups = map[string]bool{
+	"":                             true,
+	"admissionregistration.k8s.io": true,
+	"apiextensions.k8s.io":         true,
+	"apiregistration.k8s.io":       true,
+	"apps":                         true,
+	"authentication.k8s.io":        true,
+	"authorization.k8s.io":         true,
+	"autoscaling":                  true,
+	"batch":                        true,
+	"certificates.k8s.io":          true,
+	"coordination.k8s.io":          true,
+	"discovery.k8s.io":             true,
+	"events.k8s.io":                true,
+	"flowcontrol.apiserver.k8s.io": true,
+	"internal.apiserver.k8s.io":    true,
+	"networking.k8s.io":            true,
+	"node.k8s.io":                  true,
+	"policy":                       true,
+	"rbac.authorization.k8s.io":    true,
+	"resource.k8s.io":              true,
+	"scheduling.k8s.io":            true,
+	"storage.k8s.io":               true,
+	"storagemigration.k8s.io":      true,
+}
+
+// lookUpGVKinCRDs returns the openAPIV3Schema of the CRD defining a GVK, or nil when the type
+// is not defined by a CRD. Listing the CRDs is the most expensive request made when planning,
+// so the schemas found are kept for the lifetime of the provider. Types which were not found are
+// looked up again, since their CRD may be created by the same apply.
 func (ps *RawProviderServer) lookUpGVKinCRDs(ctx context.Context, gvk schema.GroupVersionKind) (interface{}, error) {
+	if builtInAPIGroups[gvk.Group] {
+		return nil, nil
+	}
+	ps.crdSchemasLock.Lock()
+	crdSchema, ok := ps.crdSchemas[gvk]
+	ps.crdSchemasLock.Unlock()
+	if ok {
+		return crdSchema, nil
+	}
+
+	crdSchema, found, err := ps.findGVKinCRDs(ctx, gvk)
+	if err != nil || !found {
+		return nil, err
+	}
+	ps.crdSchemasLock.Lock()
+	if ps.crdSchemas == nil {
+		ps.crdSchemas = make(map[schema.GroupVersionKind]interface{})
+	}
+	ps.crdSchemas[gvk] = crdSchema
+	ps.crdSchemasLock.Unlock()
+	return crdSchema, nil
+}
+
+// findGVKinCRDs lists the CRDs of the cluster to find the one defining a GVK
+func (ps *RawProviderServer) findGVKinCRDs(ctx context.Context, gvk schema.GroupVersionKind) (interface{}, bool, error) {
 	c, err := ps.getDynamicClient()
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	m, err := ps.getRestMapper()
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	crd := schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}
 	crms, err := m.RESTMappings(crd)
 	if err != nil {
-		return nil, fmt.Errorf("could not extract resource version mappings for apiextensions.k8s.io.CustomResourceDefinition: %s", err)
+		return nil, false, fmt.Errorf("could not extract resource version mappings for apiextensions.k8s.io.CustomResourceDefinition: %s", err)
 	}
 	// check  CRD versions
 	for _, crm := range crms {
 		crdRes, err := c.Resource(crm.Resource).List(ctx, v1.ListOptions{})
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		for _, r := range crdRes.Items {
@@ -255,14 +335,14 @@ func (ps *RawProviderServer) lookUpGVKinCRDs(ctx context.Context, gvk schema.Gro
 				if v["name"] == gvk.Version {
 					s, ok := v["schema"].(map[string]interface{})
 					if !ok {
-						return nil, nil // non-structural CRD
+						return nil, true, nil // non-structural CRD
 					}
-					return s["openAPIV3Schema"], nil
+					return s["openAPIV3Schema"], true, nil
 				}
 			}
 		}
 	}
-	return nil, nil
+	return nil, false, nil
 }
 
 // privateStateSchema describes the structure of the private state payload that
@@ -4,9 +4,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 )
 
 func TestRemoveNulls(t *testing.T) {
@@ -69,3 +77,65 @@ func TestRemoveNulls(t *testing.T) {
 		})
 	}
 }
+
+func TestLookUpGVKinCRDs(t *testing.T) {
+	crdGVR := schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+	crdSchema := map[string]interface{}{"type": "object"}
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "widgets.example.com"},
+		"spec": map[string]interface{}{
+			"group": "example.com",
+			"names": map[string]interface{}{"kind": "Widget"},
+			"versions": []interface{}{
+				map[string]interface{}{
+					"name":   "v1",
+					"schema": map[string]interface{}{"openAPIV3Schema": crdSchema},
+				},
+			},
+		},
+	}}
+	c := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{crdGVR: "CustomResourceDefinitionList"}, crd)
+	m := meta.NewDefaultRESTMapper([]schema.GroupVersion{crdGVR.GroupVersion()})
+	m.Add(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}, meta.RESTScopeRoot)
+	s := &RawProviderServer{logger: hclog.NewNullLogger(), dynamicClient: c, restMapper: m}
+
+	lists := func() int {
+		n := 0
+		for _, a := range c.Actions() {
+			if a.GetVerb() == "list" {
+				n++
+			}
+		}
+		return n
+	}
+	samples := []struct {
+		gvk    schema.GroupVersionKind
+		schema interface{}
+		lists  int
+	}{
+		// built-in types are never looked up
+		{schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, nil, 0},
+		{schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, nil, 0},
+		{schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}, crdSchema, 1},
+		// served from the cache
+		{schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}, crdSchema, 1},
+		// types not found are looked up again, since their CRD may be created later
+		{schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Gadget"}, nil, 2},
+		{schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Gadget"}, nil, 3},
+	}
+	for _, sample := range samples {
+		out, err := s.lookUpGVKinCRDs(context.Background(), sample.gvk)
+		if err != nil {
+			t.Fatalf("%s: %s", sample.gvk, err)
+		}
+		if !reflect.DeepEqual(out, sample.schema) {
+			t.Fatalf("%s: expected schema %v, got %v", sample.gvk, sample.schema, out)
+		}
+		if n := lists(); n != sample.lists {
+			t.Fatalf("%s: expected %d lists of CRDs, got %d", sample.gvk, sample.lists, n)
+		}
+	}
+}
@@ -0,0 +1,389 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest/openapi"
+	"github.com/mitchellh/go-homedir"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// localSchemas holds the resource types and REST mappings loaded from the
+// files listed in the "schema_sources" provider attribute. They allow planning
+// resources whose types are not (yet) known to the API server.
+type localSchemas struct {
+	kinds      map[schema.GroupVersionKind]openapi.Foundry
+	crdSchemas map[schema.GroupVersionKind]map[string]interface{}
+	mappings   []localMapping
+	mapper     *meta.DefaultRESTMapper
+}
+
+type localMapping struct {
+	gvk              schema.GroupVersionKind
+	plural, singular string
+	scope            meta.RESTScope
+}
+
+// loadSchemaSources reads CRD manifests and OpenAPI v3 documents from a list
+// of files, directories and glob patterns
+func loadSchemaSources(sources []string) (*localSchemas, error) {
+	ls := &localSchemas{
+		kinds:      make(map[schema.GroupVersionKind]openapi.Foundry),
+		crdSchemas: make(map[schema.GroupVersionKind]map[string]interface{}),
+	}
+	for _, src := range sources {
+		files, err := expandSchemaSource(src)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			if err := ls.loadFile(f); err != nil {
+				return nil, fmt.Errorf("failed to load schema source %q: %s", f, err)
+			}
+		}
+	}
+	// the mapper only searches the group-versions it was created with when no version is requested
+	gvs := []schema.GroupVersion{}
+	seen := map[schema.GroupVersion]bool{}
+	for _, m := range ls.mappings {
+		if gv := m.gvk.GroupVersion(); !seen[gv] {
+			seen[gv] = true
+			gvs = append(gvs, gv)
+		}
+	}
+	ls.mapper = meta.NewDefaultRESTMapper(gvs)
+	for _, m := range ls.mappings {
+		ls.mapper.AddSpecific(m.gvk,
+			m.gvk.GroupVersion().WithResource(m.plural),
+			m.gvk.GroupVersion().WithResource(m.singular),
+			m.scope)
+	}
+	return ls, nil
+}
+
+// expandSchemaSource resolves a schema source into the list of files it designates.
+// Directories are searched recursively for JSON and YAML files.
+func expandSchemaSource(src string) ([]string, error) {
+	p, err := homedir.Expand(src)
+	if err != nil {
+		return nil, err
+	}
+	if fi, err := os.Stat(p); err == nil && fi.IsDir() {
+		var files []string
+		err := filepath.WalkDir(p, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			switch strings.ToLower(filepath.Ext(path)) {
+			case ".json", ".yaml", ".yml":
+				if !d.IsDir() {
+					files = append(files, path)
+				}
+			}
+			return nil
+		})
+		return files, err
+	}
+	files, err := filepath.Glob(p)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema source %q: %s", src, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("schema source %q does not match any files", src)
+	}
+	return files, nil
+}
+
+func (ls *localSchemas) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	dec := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		var doc map[string]interface{}
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := ls.addDocument(doc); err != nil {
+			return err
+		}
+	}
+}
+
+func (ls *localSchemas) addDocument(doc map[string]interface{}) error {
+	if doc == nil {
+		return nil
+	}
+	if _, ok := doc["openapi"]; ok {
+		return ls.addOpenAPIDocument(doc)
+	}
+	obj := unstructured.Unstructured{Object: doc}
+	switch {
+	case obj.GroupVersionKind() == schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}:
+		return ls.addCRD(&obj)
+	case obj.IsList():
+		return obj.EachListItem(func(o runtime.Object) error {
+			return ls.addDocument(o.(*unstructured.Unstructured).Object)
+		})
+	}
+	// any other kind of manifest carries no type information
+	return nil
+}
+
+func (ls *localSchemas) addCRD(crd *unstructured.Unstructured) error {
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	plural, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "plural")
+	singular, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "singular")
+	scope, _, _ := unstructured.NestedString(crd.Object, "spec", "scope")
+	if group == "" || kind == "" || plural == "" {
+		return fmt.Errorf("CustomResourceDefinition %q is missing its group or names", crd.GetName())
+	}
+	if singular == "" {
+		singular = strings.ToLower(kind)
+	}
+	versions, _, err := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	if err != nil {
+		return fmt.Errorf("CustomResourceDefinition %q has invalid versions: %s", crd.GetName(), err)
+	}
+	for _, v := range versions {
+		ver, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(ver, "name")
+		gvk := schema.GroupVersionKind{Group: group, Version: name, Kind: kind}
+		ls.addMapping(gvk, plural, singular, scope == "Namespaced")
+
+		s, ok, _ := unstructured.NestedMap(ver, "schema", "openAPIV3Schema")
+		if !ok {
+			// non-structural CRD version, types have to be resolved by the API server
+			continue
+		}
+		js, err := json.Marshal(openapi.SchemaToSpec("", s))
+		if err != nil {
+			return fmt.Errorf("CRD schema fails to marshal into JSON: %s", err)
+		}
+		f, err := openapi.NewFoundryFromSpecV3(js)
+		if err != nil {
+			return err
+		}
+		if _, ok := ls.kinds[gvk]; !ok {
+			ls.kinds[gvk] = f
+			ls.crdSchemas[gvk] = s
+		}
+	}
+	return nil
+}
+
+// openAPIV3Document is the subset of an OpenAPI v3 document needed to
+// index the types it declares and the resources it serves
+type openAPIV3Document struct {
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas map[string]struct {
+			GVK []schema.GroupVersionKind `json:"x-kubernetes-group-version-kind"`
+		} `json:"schemas"`
+	} `json:"components"`
+}
+
+type openAPIV3Operation struct {
+	Action string                   `json:"x-kubernetes-action"`
+	GVK    *schema.GroupVersionKind `json:"x-kubernetes-group-version-kind"`
+}
+
+func (ls *localSchemas) addOpenAPIDocument(doc map[string]interface{}) error {
+	js, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	f, err := openapi.NewFoundryFromSpecV3(js)
+	if err != nil {
+		return fmt.Errorf("failed to parse OpenAPI v3 document: %s", err)
+	}
+	var idx openAPIV3Document
+	if err := json.Unmarshal(js, &idx); err != nil {
+		return err
+	}
+	for id, s := range idx.Components.Schemas {
+		if id == "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta" {
+			if _, ok := ls.kinds[openapi.ObjectMetaGVK]; !ok {
+				ls.kinds[openapi.ObjectMetaGVK] = f
+			}
+		}
+		for _, gvk := range s.GVK {
+			if _, ok := ls.kinds[gvk]; !ok {
+				ls.kinds[gvk] = f
+			}
+		}
+	}
+	// Derive REST mappings from the "get" operations on individual resources, e.g.
+	// /apis/apps/v1/namespaces/{namespace}/deployments/{name}
+	for p, item := range idx.Paths {
+		segments := strings.Split(strings.Trim(p, "/"), "/")
+		if len(segments) < 2 || segments[len(segments)-1] != "{name}" {
+			continue
+		}
+		raw, ok := item["get"]
+		if !ok {
+			continue
+		}
+		var op openAPIV3Operation
+		if err := json.Unmarshal(raw, &op); err != nil || op.Action != "get" || op.GVK == nil {
+			continue
+		}
+		namespaced := false
+		for _, s := range segments {
+			if s == "{namespace}" {
+				namespaced = true
+			}
+		}
+		ls.addMapping(*op.GVK, segments[len(segments)-2], strings.ToLower(op.GVK.Kind), namespaced)
+	}
+	return nil
+}
+
+func (ls *localSchemas) addMapping(gvk schema.GroupVersionKind, plural, singular string, namespaced bool) {
+	scope := meta.RESTScopeRoot
+	if namespaced {
+		scope = meta.RESTScopeNamespace
+	}
+	ls.mappings = append(ls.mappings, localMapping{gvk: gvk, plural: plural, singular: singular, scope: scope})
+}
+
+// foundryFor returns the foundry holding the schema of a GVK, if any of the local sources describes it
+func (ls *localSchemas) foundryFor(gvk schema.GroupVersionKind) (openapi.Foundry, bool) {
+	if ls == nil {
+		return nil, false
+	}
+	f, ok := ls.kinds[gvk]
+	return f, ok
+}
+
+// crdSchemaFor returns the raw openAPIV3Schema of a GVK defined by a CRD among the local sources, if any
+func (ls *localSchemas) crdSchemaFor(gvk schema.GroupVersionKind) (map[string]interface{}, bool) {
+	if ls == nil {
+		return nil, false
+	}
+	s, ok := ls.crdSchemas[gvk]
+	return s, ok
+}
+
+// hasType reports whether the schema of a GVK can be fully determined from
+// local sources, without consulting the API server
+func (ls *localSchemas) hasType(gvk schema.GroupVersionKind) bool {
+	if ls == nil {
+		return false
+	}
+	if _, err := ls.mapper.RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+		return false
+	}
+	_, ok := ls.kinds[gvk]
+	_, hasMeta := ls.kinds[openapi.ObjectMetaGVK]
+	return ok && hasMeta
+}
+
+// isResolvableOffline reports whether a manifest can be planned using only the local schema sources
+func (s *RawProviderServer) isResolvableOffline(manifest tftypes.Value) bool {
+	if s.localSchemas == nil || manifest.IsNull() || !manifest.IsKnown() {
+		return false
+	}
+	gvk, err := GVKFromTftypesObject(&manifest, s.localSchemas.mapper)
+	if err != nil {
+		return false
+	}
+	return s.localSchemas.hasType(gvk)
+}
+
+// localFirstRESTMapper resolves mappings from local schema sources before
+// falling back to the API server
+type localFirstRESTMapper struct {
+	local  meta.RESTMapper
+	remote meta.RESTMapper
+}
+
+var _ meta.RESTMapper = &localFirstRESTMapper{}
+
+func (m *localFirstRESTMapper) KindFor(resource schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	gvk, err := m.local.KindFor(resource)
+	if meta.IsNoMatchError(err) && m.remote != nil {
+		return m.remote.KindFor(resource)
+	}
+	return gvk, err
+}
+
+func (m *localFirstRESTMapper) KindsFor(resource schema.GroupVersionResource) ([]schema.GroupVersionKind, error) {
+	gvks, err := m.local.KindsFor(resource)
+	if meta.IsNoMatchError(err) && m.remote != nil {
+		return m.remote.KindsFor(resource)
+	}
+	return gvks, err
+}
+
+func (m *localFirstRESTMapper) ResourceFor(input schema.GroupVersionResource) (schema.GroupVersionResource, error) {
+	gvr, err := m.local.ResourceFor(input)
+	if meta.IsNoMatchError(err) && m.remote != nil {
+		return m.remote.ResourceFor(input)
+	}
+	return gvr, err
+}
+
+func (m *localFirstRESTMapper) ResourcesFor(input schema.GroupVersionResource) ([]schema.GroupVersionResource, error) {
+	gvrs, err := m.local.ResourcesFor(input)
+	if meta.IsNoMatchError(err) && m.remote != nil {
+		return m.remote.ResourcesFor(input)
+	}
+	return gvrs, err
+}
+
+func (m *localFirstRESTMapper) RESTMapping(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+	rm, err := m.local.RESTMapping(gk, versions...)
+	if meta.IsNoMatchError(err) && m.remote != nil {
+		return m.remote.RESTMapping(gk, versions...)
+	}
+	return rm, err
+}
+
+func (m *localFirstRESTMapper) RESTMappings(gk schema.GroupKind, versions ...string) ([]*meta.RESTMapping, error) {
+	rms, err := m.local.RESTMappings(gk, versions...)
+	if meta.IsNoMatchError(err) && m.remote != nil {
+		return m.remote.RESTMappings(gk, versions...)
+	}
+	return rms, err
+}
+
+func (m *localFirstRESTMapper) ResourceSingularizer(resource string) (string, error) {
+	s, err := m.local.ResourceSingularizer(resource)
+	if err != nil && m.remote != nil {
+		return m.remote.ResourceSingularizer(resource)
+	}
+	return s, err
+}
+
+// Reset invalidates the mappings discovered from the API server
+func (m *localFirstRESTMapper) Reset() {
+	if r, ok := m.remote.(meta.ResettableRESTMapper); ok {
+		r.Reset()
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/openapi/openapitest"
+)
+
+const testCRD = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  group: example.com
+  names:
+    kind: Widget
+    plural: widgets
+  scope: Namespaced
+  versions:
+    - name: v1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+          properties:
+            spec:
+              type: object
+              properties:
+                size:
+                  type: integer
+                color:
+                  type: string
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: not-a-type
+`
+
+func writeSchemaSources(t *testing.T) string {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "crds"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "crds", "widget.yaml"), []byte(testCRD), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	paths, err := openapitest.NewEmbeddedFileClient().Paths()
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec, err := paths["api/v1"].Schema("application/json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "api__v1_openapi.json"), spec, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestLoadSchemaSources(t *testing.T) {
+	dir := writeSchemaSources(t)
+
+	ls, err := loadSchemaSources([]string{filepath.Join(dir, "crds"), filepath.Join(dir, "*.json")})
+	if err != nil {
+		t.Fatalf("failed to load schema sources: %s", err)
+	}
+
+	samples := map[string]struct {
+		gvk        schema.GroupVersionKind
+		resource   string
+		namespaced bool
+	}{
+		"crd":       {schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}, "widgets", true},
+		"openapi":   {schema.GroupVersionKind{Group: "", Version: "v1", Kind: "ConfigMap"}, "configmaps", true},
+		"clustered": {schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Namespace"}, "namespaces", false},
+	}
+	for name, s := range samples {
+		t.Run(name, func(t *testing.T) {
+			m, err := ls.mapper.RESTMapping(s.gvk.GroupKind(), s.gvk.Version)
+			if err != nil {
+				t.Fatalf("no REST mapping for %s: %s", s.gvk, err)
+			}
+			if m.Resource.Resource != s.resource {
+				t.Errorf("expected resource %q, got %q", s.resource, m.Resource.Resource)
+			}
+			if (m.Scope.Name() == meta.RESTScopeNameNamespace) != s.namespaced {
+				t.Errorf("unexpected scope %q", m.Scope.Name())
+			}
+			if !ls.hasType(s.gvk) {
+				t.Errorf("type of %s should be known", s.gvk)
+			}
+		})
+	}
+
+	_, err = loadSchemaSources([]string{filepath.Join(dir, "*.txt")})
+	if err == nil {
+		t.Error("expected an error for a source matching no files")
+	}
+}
+
+func TestTFTypeFromSchemaSources(t *testing.T) {
+	dir := writeSchemaSources(t)
+	ls, err := loadSchemaSources([]string{dir})
+	if err != nil {
+		t.Fatalf("failed to load schema sources: %s", err)
+	}
+	// no client configuration: everything has to come from the schema sources
+	s := &RawProviderServer{logger: hclog.NewNullLogger(), localSchemas: ls}
+
+	manifest := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"apiVersion": tftypes.String,
+		"kind":       tftypes.String,
+	}}, map[string]tftypes.Value{
+		"apiVersion": tftypes.NewValue(tftypes.String, "example.com/v1"),
+		"kind":       tftypes.NewValue(tftypes.String, "Widget"),
+	})
+	if !s.isResolvableOffline(manifest) {
+		t.Fatal("manifest should be resolvable offline")
+	}
+
+	rm, err := s.getRestMapper()
+	if err != nil {
+		t.Fatalf("failed to get RESTMapper: %s", err)
+	}
+	gvk, err := GVKFromTftypesObject(&manifest, rm)
+	if err != nil {
+		t.Fatalf("failed to resolve GVK: %s", err)
+	}
+
+	typ, _, err := s.TFTypeFromOpenAPI(context.Background(), gvk, false)
+	if err != nil {
+		t.Fatalf("failed to resolve type: %s", err)
+	}
+	ot := typ.(tftypes.Object)
+	for _, att := range []string{"apiVersion", "kind", "metadata", "spec"} {
+		if _, ok := ot.AttributeTypes[att]; !ok {
+			t.Errorf("missing attribute %q in %s", att, typ)
+		}
+	}
+	spec := ot.AttributeTypes["spec"].(tftypes.Object)
+	if !spec.AttributeTypes["size"].Is(tftypes.Number) {
+		t.Errorf("unexpected type for spec.size: %s", spec.AttributeTypes["size"])
+	}
+
+	unknown := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"apiVersion": tftypes.String,
+		"kind":       tftypes.String,
+	}}, map[string]tftypes.Value{
+		"apiVersion": tftypes.NewValue(tftypes.String, "example.com/v1"),
+		"kind":       tftypes.NewValue(tftypes.String, "Gadget"),
+	})
+	if s.isResolvableOffline(unknown) {
+		t.Error("manifest of unknown kind should not be resolvable offline")
+	}
+}
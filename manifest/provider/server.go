@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"sync"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -13,9 +14,11 @@ import (
 	"google.golang.org/grpc/status"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/install"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 )
 
@@ -32,10 +35,17 @@ type RawProviderServer struct {
 	clientConfig        *rest.Config
 	clientConfigUnknown bool
 	dynamicClient       dynamic.Interface
+	metadataClient      metadata.Interface
 	discoveryClient     discovery.DiscoveryInterface
 	restMapper          meta.RESTMapper
 	restClient          rest.Interface
 	OAPIFoundry         openapi.Foundry
+	localSchemas        *localSchemas
+	cacheDir            string
+
+	// schemas of the CRDs found by lookUpGVKinCRDs, which lists every CRD of the cluster
+	crdSchemas     map[schema.GroupVersionKind]interface{}
+	crdSchemasLock sync.Mutex
 
 	hostTFVersion string
 }
@@ -77,6 +87,9 @@ func (s *RawProviderServer) GetMetadata(ctx context.Context, req *tfprotov5.GetM
 // ValidateDataSourceConfig function
 func (s *RawProviderServer) ValidateDataSourceConfig(ctx context.Context, req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
 	s.logger.Trace("[ValidateDataSourceConfig][Request]\n%s\n", dump(*req))
+	if req.TypeName == "kubernetes_resources" || req.TypeName == "kubernetes_resource_table" {
+		return s.validatePluralDataSource(ctx, req)
+	}
 	resp := &tfprotov5.ValidateDataSourceConfigResponse{}
 	return resp, nil
 }
@@ -34,7 +34,7 @@ func (s *RawProviderServer) UpgradeResourceState(ctx context.Context, req *tfpro
 	// if no credentials found, just loop the current state back in
 	// we do this to work around https://github.com/hashicorp/terraform/issues/30460
 	cd := s.checkValidCredentials(ctx)
-	if len(cd) > 0 {
+	if len(cd) > 0 || req.TypeName == manifestsResourceType {
 		us, err := tfprotov5.NewDynamicValue(rt, rv)
 		if err != nil {
 			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
@@ -11,10 +11,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ValidateResourceTypeConfig function
 func (s *RawProviderServer) ValidateResourceTypeConfig(ctx context.Context, req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	if req.TypeName == manifestsResourceType {
+		return s.validateManifestsResource(ctx, req)
+	}
 	resp := &tfprotov5.ValidateResourceTypeConfigResponse{}
 	requiredKeys := []string{"apiVersion", "kind", "metadata"}
 	forbiddenKeys := []string{"status"}
@@ -121,6 +125,57 @@ func (s *RawProviderServer) ValidateResourceTypeConfig(ctx context.Context, req
 		}
 	}
 
+	// validate delete options
+	if dp, ok := configVal["delete_propagation"]; ok && !dp.IsNull() && dp.IsKnown() {
+		var policy string
+		dp.As(&policy)
+		switch metav1.DeletionPropagation(policy) {
+		case metav1.DeletePropagationForeground, metav1.DeletePropagationBackground, metav1.DeletePropagationOrphan:
+		default:
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid delete_propagation",
+				Detail:    fmt.Sprintf(`%q is not a valid propagation policy. Must be one of "Foreground", "Background" or "Orphan".`, policy),
+				Attribute: tftypes.NewAttributePath().WithAttributeName("delete_propagation"),
+			})
+		}
+	}
+
+	if dp, ok := configVal["deletion_policy"]; ok && !dp.IsNull() && dp.IsKnown() {
+		var policy string
+		dp.As(&policy)
+		switch policy {
+		case deletionPolicyDelete, deletionPolicyRetain, deletionPolicyAbandon:
+		default:
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid deletion_policy",
+				Detail:    fmt.Sprintf(`%q is not a valid deletion policy. Must be one of "delete", "retain" or "abandon".`, policy),
+				Attribute: tftypes.NewAttributePath().WithAttributeName("deletion_policy"),
+			})
+		}
+	}
+
+	// validate drift policy
+	if dp, ok := configVal["drift_policy"]; ok && !dp.IsNull() && dp.IsKnown() {
+		var policy string
+		dp.As(&policy)
+		switch policy {
+		case driftPolicyIgnore, driftPolicyWarn, driftPolicyError:
+		default:
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Invalid drift_policy",
+				Detail:    fmt.Sprintf(`%q is not a valid drift policy. Must be one of "ignore", "warn" or "error".`, policy),
+				Attribute: tftypes.NewAttributePath().WithAttributeName("drift_policy"),
+			})
+		}
+	}
+
+	// validate ignored field paths
+	_, ifDiags := getIgnoreFields(configVal)
+	resp.Diagnostics = append(resp.Diagnostics, ifDiags...)
+
 	// validate wait block
 	if wait, ok := configVal["wait"]; ok && !wait.IsNull() {
 		var waitBlock []tftypes.Value
@@ -129,8 +184,20 @@ func (s *RawProviderServer) ValidateResourceTypeConfig(ctx context.Context, req
 			var w map[string]tftypes.Value
 			waitBlock[0].As(&w)
 			waiters := []string{}
+			failures := false
 			for k, ww := range w {
 				if !ww.IsNull() {
+					if strings.HasPrefix(k, "failure_") {
+						if k == "failure_condition" {
+							var cb []tftypes.Value
+							ww.As(&cb)
+							if len(cb) == 0 {
+								continue
+							}
+						}
+						failures = true
+						continue
+					}
 					if k == "condition" {
 						var cb []tftypes.Value
 						ww.As(&cb)
@@ -141,6 +208,14 @@ func (s *RawProviderServer) ValidateResourceTypeConfig(ctx context.Context, req
 					waiters = append(waiters, k)
 				}
 			}
+			if failures && len(waiters) == 0 {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "Invalid wait configuration",
+					Detail:    `"failure_condition" and "failure_fields" can only be used together with another waiter.`,
+					Attribute: tftypes.NewAttributePath().WithAttributeName("wait"),
+				})
+			}
 			if len(waiters) > 1 {
 				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
 					Severity:  tfprotov5.DiagnosticSeverityError,
@@ -149,6 +224,18 @@ func (s *RawProviderServer) ValidateResourceTypeConfig(ctx context.Context, req
 					Attribute: tftypes.NewAttributePath().WithAttributeName("wait"),
 				})
 			}
+			if expr, ok := w["expression"]; ok && !expr.IsNull() && expr.IsKnown() {
+				var e string
+				expr.As(&e)
+				if _, err := CompileWaitExpression(e); err != nil {
+					resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+						Severity:  tfprotov5.DiagnosticSeverityError,
+						Summary:   "Invalid wait expression",
+						Detail:    err.Error(),
+						Attribute: tftypes.NewAttributePath().WithAttributeName("wait").WithElementKeyInt(0).WithAttributeName("expression"),
+					})
+				}
+			}
 		}
 	}
 	if waitFor, ok := configVal["wait_for"]; ok && !waitFor.IsNull() {
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest/morph"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest/payload"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	apiservervalidation "k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+)
+
+// crdValidationSchema returns the openAPIV3Schema of the CRD defining a GVK,
+// or nil when the type is not defined by a CRD
+func (s *RawProviderServer) crdValidationSchema(ctx context.Context, gvk schema.GroupVersionKind) (map[string]interface{}, error) {
+	if cs, ok := s.localSchemas.crdSchemaFor(gvk); ok {
+		return cs, nil
+	}
+	if _, ok := s.localSchemas.foundryFor(gvk); ok {
+		// described by a local OpenAPI document rather than a CRD
+		return nil, nil
+	}
+	crdSchema, err := s.lookUpGVKinCRDs(ctx, gvk)
+	if err != nil {
+		return nil, err
+	}
+	cs, _ := crdSchema.(map[string]interface{})
+	return cs, nil
+}
+
+// validateManifestSchema checks a manifest against the validation keywords and
+// x-kubernetes-validations rules of its CRD schema, the same way the API server would on admission.
+// The manifest is validated after being morphed into the resource type (obj), while
+// the diagnostics point at the attributes of the manifest as configured (cfg).
+// Failures involving values not yet known at plan time are left to the API server.
+func (s *RawProviderServer) validateManifestSchema(ctx context.Context, gvk schema.GroupVersionKind, cfg, obj, prior tftypes.Value, hints map[string]string) []*tfprotov5.Diagnostic {
+	crdSchema, err := s.crdValidationSchema(ctx, gvk)
+	if err != nil {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to retrieve validation schema of resource",
+			Detail:   err.Error(),
+		}}
+	}
+	if crdSchema == nil {
+		return nil
+	}
+	v, err := newSchemaValidation(crdSchema)
+	if err != nil {
+		s.logger.Debug("[validateManifestSchema]", "skipping schema validation of", gvk.String(), "error", err)
+		return nil
+	}
+
+	o, err := validationPayload(obj, hints)
+	if err != nil {
+		return []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to prepare manifest for schema validation",
+			Detail:   err.Error(),
+		}}
+	}
+	var old interface{}
+	if !prior.IsNull() && prior.IsKnown() {
+		// the prior object allows evaluating transition rules referencing oldSelf
+		old, err = validationPayload(prior, hints)
+		if err != nil {
+			s.logger.Debug("[validateManifestSchema]", "ignoring prior object", err)
+			old = nil
+		}
+	}
+
+	var diags []*tfprotov5.Diagnostic
+	for _, e := range v.validate(ctx, o, old) {
+		ap, val := fieldErrorAttributePath(e.Field, cfg)
+		if !val.IsFullyKnown() {
+			continue
+		}
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Manifest fails validation against resource schema",
+			Detail:    e.Error(),
+			Attribute: tftypes.NewAttributePathWithSteps(append(tftypes.NewAttributePath().WithAttributeName("manifest").Steps(), ap.Steps()...)),
+		})
+	}
+	return diags
+}
+
+// schemaValidation holds the validators the API server builds from a CRD schema
+type schemaValidation struct {
+	validator  apiservervalidation.SchemaValidator
+	structural *structuralschema.Structural
+	cel        *cel.Validator
+}
+
+func newSchemaValidation(crdSchema map[string]interface{}) (*schemaValidation, error) {
+	js, err := json.Marshal(crdSchema)
+	if err != nil {
+		return nil, err
+	}
+	var v1props apiextensionsv1.JSONSchemaProps
+	if err := json.Unmarshal(js, &v1props); err != nil {
+		return nil, err
+	}
+	var props apiextensions.JSONSchemaProps
+	if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(&v1props, &props, nil); err != nil {
+		return nil, err
+	}
+	validator, _, err := apiservervalidation.NewSchemaValidator(&props)
+	if err != nil {
+		return nil, err
+	}
+	sv := &schemaValidation{validator: validator}
+	// defaulting and CEL rules are only supported for structural schemas
+	if ss, err := structuralschema.NewStructural(&props); err == nil {
+		sv.structural = ss
+		sv.cel = cel.NewValidator(ss, true, celconfig.PerCallLimit)
+	}
+	return sv, nil
+}
+
+func (v *schemaValidation) validate(ctx context.Context, obj, old interface{}) field.ErrorList {
+	if v.structural != nil {
+		// fields with defaults satisfy "required" once admitted
+		structuraldefaulting.Default(obj, v.structural)
+	}
+	errs := apiservervalidation.ValidateCustomResource(nil, obj, v.validator)
+	if v.cel != nil {
+		celErrs, _ := v.cel.Validate(ctx, nil, v.structural, obj, old, celconfig.RuntimeCELCostBudget)
+		errs = append(errs, celErrs...)
+	}
+	return errs
+}
+
+// validationPayload converts a resource value into the unstructured form seen by the API server
+func validationPayload(v tftypes.Value, hints map[string]string) (interface{}, error) {
+	pu, err := payload.FromTFValue(morph.UnknownToNull(v), hints, tftypes.NewAttributePath())
+	if err != nil {
+		return nil, err
+	}
+	m, ok := pu.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected payload type %T", pu)
+	}
+	return mapRemoveNulls(m), nil
+}
+
+// fieldErrorAttributePath follows a Kubernetes field path (e.g. "spec.ports[0].name" or
+// "metadata.labels[app]") through a manifest value, returning the attribute path
+// of the deepest attribute present in the manifest along with its value
+func fieldErrorAttributePath(fieldPath string, manifest tftypes.Value) (*tftypes.AttributePath, tftypes.Value) {
+	ap := tftypes.NewAttributePath()
+	cur := manifest
+	for _, step := range splitFieldPath(fieldPath) {
+		if cur.IsNull() || !cur.IsKnown() {
+			break
+		}
+		var next tftypes.Value
+		switch {
+		case cur.Type().Is(tftypes.Object{}), cur.Type().Is(tftypes.Map{}):
+			var atts map[string]tftypes.Value
+			if err := cur.As(&atts); err != nil {
+				return ap, cur
+			}
+			nv, ok := atts[step]
+			if !ok {
+				return ap, cur
+			}
+			if cur.Type().Is(tftypes.Map{}) {
+				ap = ap.WithElementKeyString(step)
+			} else {
+				ap = ap.WithAttributeName(step)
+			}
+			next = nv
+		case cur.Type().Is(tftypes.List{}), cur.Type().Is(tftypes.Tuple{}):
+			idx, err := strconv.Atoi(step)
+			if err != nil {
+				return ap, cur
+			}
+			var elems []tftypes.Value
+			if err := cur.As(&elems); err != nil || idx < 0 || idx >= len(elems) {
+				return ap, cur
+			}
+			ap = ap.WithElementKeyInt(idx)
+			next = elems[idx]
+		default:
+			return ap, cur
+		}
+		cur = next
+	}
+	return ap, cur
+}
+
+// splitFieldPath breaks the string form of a field.Path into its steps
+func splitFieldPath(p string) []string {
+	var steps []string
+	if p == "" || p == "<nil>" {
+		return steps
+	}
+	for len(p) > 0 {
+		switch p[0] {
+		case '.':
+			p = p[1:]
+		case '[':
+			end := strings.IndexByte(p, ']')
+			if end < 0 {
+				return append(steps, p[1:])
+			}
+			steps = append(steps, p[1:end])
+			p = p[end+1:]
+		default:
+			end := strings.IndexAny(p, ".[")
+			if end < 0 {
+				return append(steps, p)
+			}
+			steps = append(steps, p[:end])
+			p = p[end:]
+		}
+	}
+	return steps
+}
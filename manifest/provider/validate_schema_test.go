@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest/morph"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const testValidatedCRD = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: gizmos.example.com
+spec:
+  group: example.com
+  names:
+    kind: Gizmo
+    plural: gizmos
+  scope: Namespaced
+  versions:
+    - name: v1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+          properties:
+            spec:
+              type: object
+              required: ["color"]
+              x-kubernetes-validations:
+                - rule: "self.minSize <= self.size"
+                  message: "minSize must not exceed size"
+              properties:
+                size:
+                  type: integer
+                  maximum: 5
+                minSize:
+                  type: integer
+                  default: 1
+                color:
+                  type: string
+                  enum: ["red", "green"]
+                ports:
+                  type: array
+                  items:
+                    type: object
+                    properties:
+                      name:
+                        type: string
+                        pattern: "^[a-z]+$"
+`
+
+func TestValidateManifestSchema(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "gizmo.yaml"), []byte(testValidatedCRD), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	ls, err := loadSchemaSources([]string{dir})
+	if err != nil {
+		t.Fatalf("failed to load schema sources: %s", err)
+	}
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Gizmo"}
+	s := &RawProviderServer{logger: hclog.NewNullLogger(), localSchemas: ls}
+	lf, _ := ls.foundryFor(gvk)
+	typ, hints, err := lf.GetTypeByGVK(gvk)
+	if err != nil {
+		t.Fatalf("failed to resolve type: %s", err)
+	}
+	// the CRD only describes its own attributes
+	typ = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"apiVersion": tftypes.String,
+		"kind":       tftypes.String,
+		"spec":       typ.(tftypes.Object).AttributeTypes["spec"],
+	}}
+
+	portType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+	manifest := func(spec map[string]tftypes.Value) tftypes.Value {
+		specTypes := make(map[string]tftypes.Type, len(spec))
+		for k, v := range spec {
+			specTypes[k] = v.Type()
+		}
+		return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"apiVersion": tftypes.String,
+			"kind":       tftypes.String,
+			"spec":       tftypes.Object{AttributeTypes: specTypes},
+		}}, map[string]tftypes.Value{
+			"apiVersion": tftypes.NewValue(tftypes.String, "example.com/v1"),
+			"kind":       tftypes.NewValue(tftypes.String, "Gizmo"),
+			"spec":       tftypes.NewValue(tftypes.Object{AttributeTypes: specTypes}, spec),
+		})
+	}
+	ports := func(names ...string) tftypes.Value {
+		elems := make([]tftypes.Value, len(names))
+		types := make([]tftypes.Type, len(names))
+		for i, n := range names {
+			elems[i] = tftypes.NewValue(portType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, n)})
+			types[i] = portType
+		}
+		return tftypes.NewValue(tftypes.Tuple{ElementTypes: types}, elems)
+	}
+	specPath := tftypes.NewAttributePath().WithAttributeName("manifest").WithAttributeName("spec")
+
+	samples := map[string]struct {
+		spec   map[string]tftypes.Value
+		path   *tftypes.AttributePath
+		detail string
+	}{
+		"valid": {
+			spec: map[string]tftypes.Value{
+				"size":  tftypes.NewValue(tftypes.Number, 3),
+				"color": tftypes.NewValue(tftypes.String, "red"),
+				"ports": ports("http", "https"),
+			},
+		},
+		"maximum": {
+			spec: map[string]tftypes.Value{
+				"size":  tftypes.NewValue(tftypes.Number, 10),
+				"color": tftypes.NewValue(tftypes.String, "red"),
+			},
+			path:   specPath.WithAttributeName("size"),
+			detail: "less than or equal to 5",
+		},
+		"enum": {
+			spec: map[string]tftypes.Value{
+				"size":  tftypes.NewValue(tftypes.Number, 3),
+				"color": tftypes.NewValue(tftypes.String, "blue"),
+			},
+			path:   specPath.WithAttributeName("color"),
+			detail: "Unsupported value",
+		},
+		"required": {
+			spec: map[string]tftypes.Value{
+				"size": tftypes.NewValue(tftypes.Number, 3),
+			},
+			path:   specPath,
+			detail: "Required value",
+		},
+		"pattern": {
+			spec: map[string]tftypes.Value{
+				"size":  tftypes.NewValue(tftypes.Number, 3),
+				"color": tftypes.NewValue(tftypes.String, "green"),
+				"ports": ports("http", "HTTPS"),
+			},
+			path:   specPath.WithAttributeName("ports").WithElementKeyInt(1).WithAttributeName("name"),
+			detail: "should match",
+		},
+		"rule": {
+			spec: map[string]tftypes.Value{
+				"size":    tftypes.NewValue(tftypes.Number, 3),
+				"minSize": tftypes.NewValue(tftypes.Number, 4),
+				"color":   tftypes.NewValue(tftypes.String, "green"),
+			},
+			path:   specPath,
+			detail: "minSize must not exceed size",
+		},
+		"unknown": {
+			spec: map[string]tftypes.Value{
+				"size":    tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				"minSize": tftypes.NewValue(tftypes.Number, 4),
+				"color":   tftypes.NewValue(tftypes.String, "green"),
+			},
+		},
+	}
+	for name, sample := range samples {
+		t.Run(name, func(t *testing.T) {
+			cfg := manifest(sample.spec)
+			obj, d := morph.ValueToType(cfg, typ, tftypes.NewAttributePath())
+			if len(d) > 0 {
+				t.Fatalf("failed to morph manifest: %v", d)
+			}
+			nullPrior := tftypes.NewValue(typ, nil)
+			diags := s.validateManifestSchema(context.Background(), gvk, cfg, obj, nullPrior, hints)
+			if sample.path == nil {
+				if len(diags) > 0 {
+					t.Fatalf("unexpected diagnostics: %s: %s", diags[0].Summary, diags[0].Detail)
+				}
+				return
+			}
+			if len(diags) != 1 {
+				t.Fatalf("expected one diagnostic, got %d", len(diags))
+			}
+			if !diags[0].Attribute.Equal(sample.path) {
+				t.Errorf("expected diagnostic at %s, got %s", sample.path, diags[0].Attribute)
+			}
+			if !strings.Contains(diags[0].Detail, sample.detail) {
+				t.Errorf("expected detail to contain %q, got %q", sample.detail, diags[0].Detail)
+			}
+		})
+	}
+}
+
+func TestSplitFieldPath(t *testing.T) {
+	samples := map[string][]string{
+		"":                                     nil,
+		"spec":                                 {"spec"},
+		"spec.ports[0].name":                   {"spec", "ports", "0", "name"},
+		"metadata.labels[app.kubernetes.io/x]": {"metadata", "labels", "app.kubernetes.io/x"},
+	}
+	for in, expected := range samples {
+		steps := splitFieldPath(in)
+		if strings.Join(steps, "|") != strings.Join(expected, "|") {
+			t.Errorf("splitting %q: expected %q, got %q", in, expected, steps)
+		}
+	}
+}
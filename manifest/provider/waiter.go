@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"math/big"
 	"regexp"
+	"sort"
 	"time"
 
+	"github.com/google/cel-go/cel"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/payload"
@@ -17,8 +19,14 @@ import (
 
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/kubectl/pkg/polymorphichelpers"
 )
@@ -37,6 +45,175 @@ func (s *RawProviderServer) waitForCompletion(ctx context.Context, waitForBlock
 	return waiter.Wait(ctx)
 }
 
+// maxRecentEvents is the number of events included in wait failure diagnostics
+const maxRecentEvents = 10
+
+// recentEvents returns a description of the most recent events
+// that have been recorded for the object, oldest first
+func (s *RawProviderServer) recentEvents(ctx context.Context, obj *unstructured.Unstructured) []string {
+	if obj == nil {
+		return nil
+	}
+	c, err := s.getDynamicClient()
+	if err != nil {
+		return nil
+	}
+	ns := obj.GetNamespace()
+	if ns == "" {
+		ns = v1.NamespaceDefault
+	}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "events"}
+	list, err := c.Resource(gvr).Namespace(ns).List(ctx, v1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("involvedObject.uid", string(obj.GetUID())).String(),
+	})
+	if err != nil {
+		s.logger.Debug("[ApplyResourceChange][Wait] Failed to list events", "error", err)
+		return nil
+	}
+
+	events := make([]corev1.Event, 0, len(list.Items))
+	for _, item := range list.Items {
+		var ev corev1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &ev); err != nil {
+			continue
+		}
+		events = append(events, ev)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return eventTime(events[i]).Before(eventTime(events[j]))
+	})
+	if len(events) > maxRecentEvents {
+		events = events[len(events)-maxRecentEvents:]
+	}
+
+	out := make([]string, 0, len(events))
+	for _, ev := range events {
+		out = append(out, fmt.Sprintf("%s %s: %s", ev.Type, ev.Reason, ev.Message))
+	}
+	return out
+}
+
+func eventTime(ev corev1.Event) time.Time {
+	switch {
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	}
+	return ev.CreationTimestamp.Time
+}
+
+// watchResource calls check with the current state of the named resource and
+// again every time it changes, until check returns true or an error, or ctx
+// expires. Changes are observed with a watch which is resumed from the last
+// seen resourceVersion whenever the server closes it. If the watch cannot be
+// established (e.g. missing RBAC permissions) it falls back to polling.
+func watchResource(ctx context.Context, rs dynamic.ResourceInterface, name string, logger hclog.Logger, check func(*unstructured.Unstructured) (bool, error)) error {
+	res, err := rs.Get(ctx, name, v1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	done, err := check(res)
+	if done || err != nil {
+		return err
+	}
+	rv := res.GetResourceVersion()
+
+	for {
+		w, err := rs.Watch(ctx, v1.ListOptions{
+			FieldSelector:       fields.OneTermEqualSelector("metadata.name", name).String(),
+			ResourceVersion:     rv,
+			AllowWatchBookmarks: true,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Debug("[ApplyResourceChange][Wait] Cannot watch resource, falling back to polling", "error", err)
+			return pollResource(ctx, rs, name, check)
+		}
+
+		rv, done, err = consumeWatch(ctx, w, rv, check)
+		w.Stop()
+		if done || err != nil {
+			return err
+		}
+		if rv == "" {
+			// the resourceVersion we were watching from is too old,
+			// start over from the current state of the resource
+			res, err := rs.Get(ctx, name, v1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			done, err := check(res)
+			if done || err != nil {
+				return err
+			}
+			rv = res.GetResourceVersion()
+		}
+	}
+}
+
+// consumeWatch processes events from w until check returns true or an error,
+// or the watch is closed by the server. It returns the last resourceVersion
+// seen, which is empty when the watch has to be restarted from scratch.
+func consumeWatch(ctx context.Context, w watch.Interface, rv string, check func(*unstructured.Unstructured) (bool, error)) (string, bool, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return rv, false, ctx.Err()
+		case ev, ok := <-w.ResultChan():
+			if !ok {
+				return rv, false, nil
+			}
+			switch ev.Type {
+			case watch.Added, watch.Modified:
+				res, ok := ev.Object.(*unstructured.Unstructured)
+				if !ok {
+					continue
+				}
+				rv = res.GetResourceVersion()
+				done, err := check(res)
+				if done || err != nil {
+					return rv, done, err
+				}
+			case watch.Bookmark:
+				if res, ok := ev.Object.(*unstructured.Unstructured); ok {
+					rv = res.GetResourceVersion()
+				}
+			case watch.Deleted:
+				return rv, false, fmt.Errorf("resource was deleted")
+			case watch.Error:
+				err := errors.FromObject(ev.Object)
+				if errors.IsResourceExpired(err) || errors.IsGone(err) {
+					return "", false, nil
+				}
+				return rv, false, err
+			}
+		}
+	}
+}
+
+// pollResource calls check with the current state of the named resource
+// at a fixed interval until it returns true or an error, or ctx expires.
+func pollResource(ctx context.Context, rs dynamic.ResourceInterface, name string, check func(*unstructured.Unstructured) (bool, error)) error {
+	for {
+		res, err := rs.Get(ctx, name, v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		done, err := check(res)
+		if done || err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(waiterSleepTime):
+		}
+	}
+}
+
 // Waiter is a simple interface to implement a blocking wait operation
 type Waiter interface {
 	Wait(context.Context) error
@@ -50,6 +227,17 @@ func (e WaiterError) Error() string {
 	return fmt.Sprintf("timed out waiting on %v", e.Reason)
 }
 
+// WaiterFailureError is returned when a resource reaches
+// one of the failure states configured in the wait block
+type WaiterFailureError struct {
+	Reason string
+	Object *unstructured.Unstructured
+}
+
+func (e WaiterFailureError) Error() string {
+	return fmt.Sprintf("resource reached a failure state: %v", e.Reason)
+}
+
 // NewResourceWaiter constructs an appropriate Waiter using the supplied waitForBlock configuration
 func NewResourceWaiter(resource dynamic.ResourceInterface, resourceName string, resourceType tftypes.Type, th map[string]string, waitForBlock tftypes.Value, hl hclog.Logger) (Waiter, error) {
 	var waitForBlockVal map[string]tftypes.Value
@@ -58,6 +246,11 @@ func NewResourceWaiter(resource dynamic.ResourceInterface, resourceName string,
 		return nil, err
 	}
 
+	failure, err := NewFailureMatcher(waitForBlockVal, resourceType, th)
+	if err != nil {
+		return nil, err
+	}
+
 	if v, ok := waitForBlockVal["rollout"]; ok {
 		var rollout bool
 		v.As(&rollout)
@@ -66,6 +259,20 @@ func NewResourceWaiter(resource dynamic.ResourceInterface, resourceName string,
 				resource,
 				resourceName,
 				hl,
+				failure,
+			}, nil
+		}
+	}
+
+	if v, ok := waitForBlockVal["ready"]; ok {
+		var ready bool
+		v.As(&ready)
+		if ready {
+			return &ReadyWaiter{
+				resource,
+				resourceName,
+				hl,
+				failure,
 			}, nil
 		}
 	}
@@ -79,6 +286,26 @@ func NewResourceWaiter(resource dynamic.ResourceInterface, resourceName string,
 				resourceName,
 				conditionsBlocks,
 				hl,
+				failure,
+			}, nil
+		}
+	}
+
+	if v, ok := waitForBlockVal["expression"]; ok && !v.IsNull() && v.IsKnown() {
+		var expr string
+		v.As(&expr)
+		if expr != "" {
+			prg, err := CompileWaitExpression(expr)
+			if err != nil {
+				return nil, err
+			}
+			return &ExpressionWaiter{
+				resource,
+				resourceName,
+				expr,
+				prg,
+				hl,
+				failure,
 			}, nil
 		}
 	}
@@ -88,6 +315,26 @@ func NewResourceWaiter(resource dynamic.ResourceInterface, resourceName string,
 		return &NoopWaiter{}, nil
 	}
 
+	matchers, err := parseFieldMatchers(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FieldWaiter{
+		resource,
+		resourceName,
+		resourceType,
+		th,
+		matchers,
+		hl,
+		failure,
+	}, nil
+
+}
+
+// parseFieldMatchers builds a FieldMatcher for each entry in a map
+// of field paths to regular expressions
+func parseFieldMatchers(fields tftypes.Value) ([]FieldMatcher, error) {
 	if !fields.Type().Is(tftypes.Map{}) {
 		return nil, fmt.Errorf(`"fields" should be a map of strings`)
 	}
@@ -116,24 +363,16 @@ func NewResourceWaiter(resource dynamic.ResourceInterface, resourceName string,
 		if err != nil {
 			return nil, err
 		}
-		matchers = append(matchers, FieldMatcher{p, re})
+		matchers = append(matchers, FieldMatcher{p, re, k})
 	}
-
-	return &FieldWaiter{
-		resource,
-		resourceName,
-		resourceType,
-		th,
-		matchers,
-		hl,
-	}, nil
-
+	return matchers, nil
 }
 
 // FieldMatcher contains a tftypes.AttributePath to a field and a regexp to match on it
 type FieldMatcher struct {
 	path         *tftypes.AttributePath
 	valueMatcher *regexp.Regexp
+	fieldPath    string
 }
 
 // FieldWaiter will wait for a set of fields to be set,
@@ -145,28 +384,13 @@ type FieldWaiter struct {
 	typeHints     map[string]string
 	fieldMatchers []FieldMatcher
 	logger        hclog.Logger
+	failure       *FailureMatcher
 }
 
 // Wait blocks until all of the FieldMatchers configured evaluate to true
 func (w *FieldWaiter) Wait(ctx context.Context) error {
 	w.logger.Info("[ApplyResourceChange][Wait] Waiting until ready...\n")
-	for {
-		if deadline, ok := ctx.Deadline(); ok {
-			if time.Now().After(deadline) {
-				return WaiterError{Reason: "field matchers"}
-			}
-		}
-
-		// NOTE The typed API resource is actually returned in the
-		// event object but I haven't yet figured out how to convert it
-		// to a cty.Value.
-		res, err := w.resource.Get(ctx, w.resourceName, v1.GetOptions{})
-		if err != nil {
-			return err
-		}
-		if errors.IsGone(err) {
-			return fmt.Errorf("resource was deleted")
-		}
+	err := watchResource(ctx, w.resource, w.resourceName, w.logger, w.failure.guard(func(res *unstructured.Unstructured) (bool, error) {
 		resObj := res.Object
 		meta := resObj["metadata"].(map[string]interface{})
 		delete(meta, "managedFields")
@@ -175,7 +399,7 @@ func (w *FieldWaiter) Wait(ctx context.Context) error {
 
 		obj, err := payload.ToTFValue(resObj, w.resourceType, w.typeHints, tftypes.NewAttributePath())
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		done, err := func(obj tftypes.Value) (bool, error) {
@@ -188,27 +412,9 @@ func (w *FieldWaiter) Wait(ctx context.Context) error {
 					return false, fmt.Errorf("attribute not present at path '%s'", m.path.String())
 				}
 
-				var s string
-				v := vi.(tftypes.Value)
-				switch {
-				case v.Type().Is(tftypes.String):
-					v.As(&s)
-				case v.Type().Is(tftypes.Bool):
-					var vb bool
-					v.As(&vb)
-					s = fmt.Sprintf("%t", vb)
-				case v.Type().Is(tftypes.Number):
-					var f big.Float
-					v.As(&f)
-					if f.IsInt() {
-						i, _ := f.Int64()
-						s = fmt.Sprintf("%d", i)
-					} else {
-						i, _ := f.Float64()
-						s = fmt.Sprintf("%f", i)
-					}
-				default:
-					return true, fmt.Errorf("wait_for: cannot match on type %q", v.Type().String())
+				s, err := fieldValueString(vi.(tftypes.Value))
+				if err != nil {
+					return true, err
 				}
 
 				if !m.valueMatcher.Match([]byte(s)) {
@@ -218,14 +424,159 @@ func (w *FieldWaiter) Wait(ctx context.Context) error {
 
 			return true, nil
 		}(obj)
-
 		if done {
-			w.logger.Info("[ApplyResourceChange][Wait] Done waiting.\n")
+			return true, err
+		}
+		// NOTE fields may not have been populated yet, keep waiting
+		return false, nil
+	}))
+	if err != nil {
+		if ctx.Err() != nil {
+			return WaiterError{Reason: "field matchers"}
+		}
+		return err
+	}
+
+	w.logger.Info("[ApplyResourceChange][Wait] Done waiting.\n")
+	return nil
+}
+
+// fieldValueString renders a primitive value as a string so
+// it can be matched against a regular expression
+func fieldValueString(v tftypes.Value) (string, error) {
+	var s string
+	switch {
+	case v.Type().Is(tftypes.String):
+		v.As(&s)
+	case v.Type().Is(tftypes.Bool):
+		var vb bool
+		v.As(&vb)
+		s = fmt.Sprintf("%t", vb)
+	case v.Type().Is(tftypes.Number):
+		var f big.Float
+		v.As(&f)
+		if f.IsInt() {
+			i, _ := f.Int64()
+			s = fmt.Sprintf("%d", i)
+		} else {
+			i, _ := f.Float64()
+			s = fmt.Sprintf("%f", i)
+		}
+	default:
+		return "", fmt.Errorf("wait_for: cannot match on type %q", v.Type().String())
+	}
+	return s, nil
+}
+
+// FailureMatcher detects when a resource has reached a terminal
+// failure state so that waiting can be aborted early
+type FailureMatcher struct {
+	conditions    []tftypes.Value
+	fieldMatchers []FieldMatcher
+	resourceType  tftypes.Type
+	typeHints     map[string]string
+}
+
+// NewFailureMatcher constructs a FailureMatcher from the "failure_condition" and
+// "failure_fields" settings of the wait block. It returns nil if neither is set.
+func NewFailureMatcher(waitForBlockVal map[string]tftypes.Value, resourceType tftypes.Type, th map[string]string) (*FailureMatcher, error) {
+	m := FailureMatcher{
+		resourceType: resourceType,
+		typeHints:    th,
+	}
+	if v, ok := waitForBlockVal["failure_condition"]; ok && !v.IsNull() && v.IsKnown() {
+		v.As(&m.conditions)
+	}
+	if v, ok := waitForBlockVal["failure_fields"]; ok && !v.IsNull() && v.IsKnown() {
+		matchers, err := parseFieldMatchers(v)
+		if err != nil {
+			return nil, err
+		}
+		m.fieldMatchers = matchers
+	}
+	if len(m.conditions) == 0 && len(m.fieldMatchers) == 0 {
+		return nil, nil
+	}
+	return &m, nil
+}
+
+// Check returns a WaiterFailureError if the resource matches
+// any of the configured failure conditions or fields
+func (m *FailureMatcher) Check(res *unstructured.Unstructured) error {
+	if m == nil {
+		return nil
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(res.Object, "status", "conditions")
+	for _, c := range m.conditions {
+		var condition map[string]tftypes.Value
+		c.As(&condition)
+		var conditionType, conditionStatus, conditionReason string
+		condition["type"].As(&conditionType)
+		condition["status"].As(&conditionStatus)
+		condition["reason"].As(&conditionReason)
+		for _, cc := range conditions {
+			ccc, ok := cc.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			ctype, _, _ := unstructured.NestedString(ccc, "type")
+			status, _, _ := unstructured.NestedString(ccc, "status")
+			reason, _, _ := unstructured.NestedString(ccc, "reason")
+			message, _, _ := unstructured.NestedString(ccc, "message")
+			if ctype != conditionType {
+				continue
+			}
+			if (conditionStatus == "" || status == conditionStatus) && (conditionReason == "" || reason == conditionReason) {
+				return WaiterFailureError{
+					Reason: fmt.Sprintf("condition %s=%s: %s", ctype, status, conditionDescription(reason, message)),
+					Object: res,
+				}
+			}
+		}
+	}
+
+	if len(m.fieldMatchers) == 0 {
+		return nil
+	}
+	resObj := res.DeepCopy().Object
+	if meta, ok := resObj["metadata"].(map[string]interface{}); ok {
+		delete(meta, "managedFields")
+	}
+	obj, err := payload.ToTFValue(resObj, m.resourceType, m.typeHints, tftypes.NewAttributePath())
+	if err != nil {
+		return err
+	}
+	for _, fm := range m.fieldMatchers {
+		vi, rp, err := tftypes.WalkAttributePath(obj, fm.path)
+		if err != nil || len(rp.Steps()) > 0 {
+			// NOTE the field is not present so it cannot indicate a failure
+			continue
+		}
+		s, err := fieldValueString(vi.(tftypes.Value))
+		if err != nil {
 			return err
 		}
+		if fm.valueMatcher.Match([]byte(s)) {
+			return WaiterFailureError{
+				Reason: fmt.Sprintf("field %s is %q", fm.fieldPath, s),
+				Object: res,
+			}
+		}
+	}
+	return nil
+}
 
-		// TODO: implement with exponential back-off.
-		time.Sleep(waiterSleepTime) // lintignore:R018
+// guard wraps check so that the failure conditions are evaluated first
+func (m *FailureMatcher) guard(check func(*unstructured.Unstructured) (bool, error)) func(*unstructured.Unstructured) (bool, error) {
+	if m == nil {
+		return check
+	}
+	return func(res *unstructured.Unstructured) (bool, error) {
+		if err := m.Check(res); err != nil {
+			return false, err
+		}
+		return check(res)
 	}
 }
 
@@ -283,42 +634,30 @@ type RolloutWaiter struct {
 	resource     dynamic.ResourceInterface
 	resourceName string
 	logger       hclog.Logger
+	failure      *FailureMatcher
 }
 
 // Wait uses StatusViewer to determine if the rollout is done
 func (w *RolloutWaiter) Wait(ctx context.Context) error {
 	w.logger.Info("[ApplyResourceChange][Wait] Waiting until rollout complete...\n")
-	for {
-		if deadline, ok := ctx.Deadline(); ok {
-			if time.Now().After(deadline) {
-				return WaiterError{Reason: "rollout to complete"}
-			}
-		}
-
-		res, err := w.resource.Get(ctx, w.resourceName, v1.GetOptions{})
-		if err != nil {
-			return err
-		}
-		if errors.IsGone(err) {
-			return fmt.Errorf("resource was deleted")
-		}
-
+	err := watchResource(ctx, w.resource, w.resourceName, w.logger, w.failure.guard(func(res *unstructured.Unstructured) (bool, error) {
 		gk := res.GetObjectKind().GroupVersionKind().GroupKind()
 		statusViewer, err := polymorphichelpers.StatusViewerFor(gk)
 		if err != nil {
-			return fmt.Errorf("error getting resource status: %v", err)
+			return false, fmt.Errorf("error getting resource status: %v", err)
 		}
 
 		_, done, err := statusViewer.Status(res, 0)
 		if err != nil {
-			return fmt.Errorf("error getting resource status: %v", err)
+			return false, fmt.Errorf("error getting resource status: %v", err)
 		}
-
-		if done {
-			break
+		return done, nil
+	}))
+	if err != nil {
+		if ctx.Err() != nil {
+			return WaiterError{Reason: "rollout to complete"}
 		}
-
-		time.Sleep(waiterSleepTime) // lintignore:R018
+		return err
 	}
 
 	w.logger.Info("[ApplyResourceChange][Wait] Rollout complete\n")
@@ -332,54 +671,218 @@ type ConditionsWaiter struct {
 	resourceName string
 	conditions   []tftypes.Value
 	logger       hclog.Logger
+	failure      *FailureMatcher
 }
 
 // Wait checks all the configured conditions have been met
 func (w *ConditionsWaiter) Wait(ctx context.Context) error {
 	w.logger.Info("[ApplyResourceChange][Wait] Waiting for conditions...\n")
 
-	for {
-		if deadline, ok := ctx.Deadline(); ok {
-			if time.Now().After(deadline) {
-				return WaiterError{Reason: "conditions"}
-			}
+	err := watchResource(ctx, w.resource, w.resourceName, w.logger, w.failure.guard(func(res *unstructured.Unstructured) (bool, error) {
+		status, ok := res.Object["status"].(map[string]interface{})
+		if !ok {
+			return false, nil
 		}
-
-		res, err := w.resource.Get(ctx, w.resourceName, v1.GetOptions{})
-		if err != nil {
-			return err
+		conditions, ok := status["conditions"].([]interface{})
+		if !ok || len(conditions) == 0 {
+			return false, nil
 		}
-		if errors.IsGone(err) {
-			return fmt.Errorf("resource was deleted")
-		}
-
-		if status, ok := res.Object["status"].(map[string]interface{}); ok {
-			if conditions, ok := status["conditions"].([]interface{}); ok && len(conditions) > 0 {
-				conditionsMet := true
-				for _, c := range w.conditions {
-					var condition map[string]tftypes.Value
-					c.As(&condition)
-					var conditionType, conditionStatus string
-					condition["type"].As(&conditionType)
-					condition["status"].As(&conditionStatus)
-					conditionMet := false
-					for _, cc := range conditions {
-						ccc := cc.(map[string]interface{})
-						if ccc["type"].(string) == conditionType {
-							conditionMet = ccc["status"].(string) == conditionStatus
-							break
-						}
-					}
-					conditionsMet = conditionsMet && conditionMet
-				}
-				if conditionsMet {
+		conditionsMet := true
+		for _, c := range w.conditions {
+			var condition map[string]tftypes.Value
+			c.As(&condition)
+			var conditionType, conditionStatus string
+			condition["type"].As(&conditionType)
+			condition["status"].As(&conditionStatus)
+			conditionMet := false
+			for _, cc := range conditions {
+				ccc := cc.(map[string]interface{})
+				if ccc["type"].(string) == conditionType {
+					conditionMet = ccc["status"].(string) == conditionStatus
 					break
 				}
 			}
+			conditionsMet = conditionsMet && conditionMet
+		}
+		return conditionsMet, nil
+	}))
+	if err != nil {
+		if ctx.Err() != nil {
+			return WaiterError{Reason: "conditions"}
 		}
-		time.Sleep(waiterSleepTime) // lintignore:R018
+		return err
 	}
 
 	w.logger.Info("[ApplyResourceChange][Wait] All conditions met.\n")
 	return nil
 }
+
+// ReadyWaiter will wait for a resource to become ready using the
+// generic readiness rules of kstatus, which apply to any resource
+// that follows the Kubernetes API conventions for status
+type ReadyWaiter struct {
+	resource     dynamic.ResourceInterface
+	resourceName string
+	logger       hclog.Logger
+	failure      *FailureMatcher
+}
+
+// Wait blocks until the resource is ready or reports that it is stalled
+func (w *ReadyWaiter) Wait(ctx context.Context) error {
+	w.logger.Info("[ApplyResourceChange][Wait] Waiting until ready...\n")
+
+	var lastMessage string
+	err := watchResource(ctx, w.resource, w.resourceName, w.logger, w.failure.guard(func(res *unstructured.Unstructured) (bool, error) {
+		ready, msg, err := resourceReadiness(res)
+		lastMessage = msg
+		if err != nil {
+			return false, WaiterFailureError{Reason: err.Error(), Object: res}
+		}
+		return ready, nil
+	}))
+	if err != nil {
+		if ctx.Err() != nil {
+			reason := "resource to become ready"
+			if lastMessage != "" {
+				reason = fmt.Sprintf("%s (%s)", reason, lastMessage)
+			}
+			return WaiterError{Reason: reason}
+		}
+		return err
+	}
+
+	w.logger.Info("[ApplyResourceChange][Wait] Resource is ready.\n")
+	return nil
+}
+
+// resourceReadiness computes whether a resource is ready in the same way kstatus
+// does for resources it has no specific rules for. It returns a message describing
+// why the resource is not ready yet, or an error if the resource is stalled.
+func resourceReadiness(res *unstructured.Unstructured) (bool, string, error) {
+	generation := res.GetGeneration()
+	observedGeneration, found, err := unstructured.NestedInt64(res.Object, "status", "observedGeneration")
+	if err == nil && found && generation != 0 && observedGeneration < generation {
+		return false, fmt.Sprintf("observed generation %d is behind generation %d", observedGeneration, generation), nil
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(res.Object, "status", "conditions")
+	var ready map[string]interface{}
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ctype, _, _ := unstructured.NestedString(cond, "type")
+		status, _, _ := unstructured.NestedString(cond, "status")
+		reason, _, _ := unstructured.NestedString(cond, "reason")
+		message, _, _ := unstructured.NestedString(cond, "message")
+		switch ctype {
+		case "Stalled":
+			if status == "True" {
+				return false, "", fmt.Errorf("resource is stalled: %s", conditionDescription(reason, message))
+			}
+		case "Reconciling":
+			if status == "True" {
+				return false, fmt.Sprintf("resource is reconciling: %s", conditionDescription(reason, message)), nil
+			}
+		case "Ready":
+			ready = cond
+		}
+	}
+
+	if ready == nil {
+		// NOTE like kstatus, resources which don't report a
+		// Ready condition are considered ready at this point
+		return true, "", nil
+	}
+	status, _, _ := unstructured.NestedString(ready, "status")
+	if status != "True" {
+		reason, _, _ := unstructured.NestedString(ready, "reason")
+		message, _, _ := unstructured.NestedString(ready, "message")
+		return false, fmt.Sprintf("Ready condition is %q: %s", status, conditionDescription(reason, message)), nil
+	}
+	return true, "", nil
+}
+
+func conditionDescription(reason, message string) string {
+	switch {
+	case reason != "" && message != "":
+		return fmt.Sprintf("%s: %s", reason, message)
+	case reason != "":
+		return reason
+	case message != "":
+		return message
+	}
+	return "no reason given"
+}
+
+// CompileWaitExpression parses and type-checks a CEL expression to be evaluated
+// against a resource. The resource is available to the expression as "self".
+func CompileWaitExpression(expr string) (cel.Program, error) {
+	env, err := cel.NewEnv(cel.Variable("self", cel.DynType))
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("invalid expression %q: %s", expr, iss.Err())
+	}
+	if ot := ast.OutputType(); !cel.BoolType.IsAssignableType(ot) && ot.String() != cel.DynType.String() {
+		return nil, fmt.Errorf("expression %q must evaluate to a bool, got %s", expr, ast.OutputType())
+	}
+	return env.Program(ast)
+}
+
+// ExpressionWaiter will wait for a CEL expression evaluated
+// against the resource to return true
+type ExpressionWaiter struct {
+	resource     dynamic.ResourceInterface
+	resourceName string
+	expression   string
+	program      cel.Program
+	logger       hclog.Logger
+	failure      *FailureMatcher
+}
+
+// Wait blocks until the expression evaluates to true
+func (w *ExpressionWaiter) Wait(ctx context.Context) error {
+	w.logger.Info("[ApplyResourceChange][Wait] Waiting for expression...\n")
+
+	var lastErr error
+	err := watchResource(ctx, w.resource, w.resourceName, w.logger, w.failure.guard(func(res *unstructured.Unstructured) (bool, error) {
+		done, err := evalWaitExpression(w.program, res.Object)
+		if err != nil {
+			// NOTE fields referenced by the expression may not have
+			// been populated by the controller yet, so evaluation
+			// errors are not fatal here.
+			w.logger.Trace("[ApplyResourceChange][Wait]", "expression evaluation error", err)
+		}
+		lastErr = err
+		return done, nil
+	}))
+	if err != nil {
+		if ctx.Err() != nil {
+			reason := fmt.Sprintf("expression %q", w.expression)
+			if lastErr != nil {
+				reason = fmt.Sprintf("%s (last evaluation error: %s)", reason, lastErr)
+			}
+			return WaiterError{Reason: reason}
+		}
+		return err
+	}
+
+	w.logger.Info("[ApplyResourceChange][Wait] Expression evaluated to true.\n")
+	return nil
+}
+
+func evalWaitExpression(prg cel.Program, obj map[string]interface{}) (bool, error) {
+	out, _, err := prg.Eval(map[string]interface{}{"self": obj})
+	if err != nil {
+		return false, err
+	}
+	b, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression returned %s, expected bool", out.Type())
+	}
+	return b, nil
+}
@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestWaitExpression(t *testing.T) {
+	obj := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"generation": int64(2),
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+		},
+		"status": map[string]interface{}{
+			"observedGeneration": int64(2),
+			"readyReplicas":      int64(3),
+		},
+	}
+
+	samples := []struct {
+		expr       string
+		compileErr bool
+		evalErr    bool
+		result     bool
+	}{
+		{expr: "self.status.readyReplicas >= self.spec.replicas && self.status.observedGeneration == self.metadata.generation", result: true},
+		{expr: "self.status.readyReplicas > self.spec.replicas", result: false},
+		{expr: "has(self.status.conditions)", result: false},
+		{expr: "self.status.conditions.exists(c, c.type == 'Ready')", evalErr: true},
+		{expr: "self.spec.replicas", evalErr: true},
+		{expr: "1 + 2", compileErr: true},
+		{expr: "self.spec.replicas ==", compileErr: true},
+	}
+
+	for _, s := range samples {
+		prg, err := CompileWaitExpression(s.expr)
+		if s.compileErr {
+			if err == nil {
+				t.Errorf("expected compile error for %q", s.expr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected compile error for %q: %s", s.expr, err)
+			continue
+		}
+		r, err := evalWaitExpression(prg, obj)
+		if s.evalErr {
+			if err == nil {
+				t.Errorf("expected evaluation error for %q", s.expr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected evaluation error for %q: %s", s.expr, err)
+			continue
+		}
+		if r != s.result {
+			t.Errorf("expression %q: expected %t, got %t", s.expr, s.result, r)
+		}
+	}
+}
+
+var testWidgetGVR = schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+
+func newTestWidget(ready bool) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"metadata": map[string]interface{}{
+				"name":      "test",
+				"namespace": "default",
+			},
+			"status": map[string]interface{}{
+				"ready": ready,
+			},
+		},
+	}
+}
+
+func newTestExpressionWaiter(t *testing.T, rs dynamic.ResourceInterface) *ExpressionWaiter {
+	expr := "self.status.ready == true"
+	prg, err := CompileWaitExpression(expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &ExpressionWaiter{rs, "test", expr, prg, hclog.NewNullLogger(), nil}
+}
+
+func TestWatchWaiter(t *testing.T) {
+	samples := map[string]struct {
+		failWatch bool
+		delete    bool
+		timeout   bool
+	}{
+		"watch":    {},
+		"poll":     {failWatch: true},
+		"deleted":  {delete: true},
+		"timedout": {timeout: true},
+	}
+
+	for name, s := range samples {
+		t.Run(name, func(t *testing.T) {
+			c := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), newTestWidget(false))
+			if s.failWatch {
+				c.PrependWatchReactor("widgets", func(action k8stesting.Action) (bool, watch.Interface, error) {
+					return true, nil, fmt.Errorf("watch is forbidden")
+				})
+			}
+			rs := c.Resource(testWidgetGVR).Namespace("default")
+			w := newTestExpressionWaiter(t, rs)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			if s.timeout {
+				cancel()
+				ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+			}
+			defer cancel()
+
+			errCh := make(chan error)
+			go func() {
+				errCh <- w.Wait(ctx)
+			}()
+
+			// NOTE keep changing the object until the waiter returns so
+			// the test does not depend on when the watch is established
+			tick := time.NewTicker(50 * time.Millisecond)
+			defer tick.Stop()
+			for {
+				select {
+				case err := <-errCh:
+					switch {
+					case s.timeout:
+						if _, ok := err.(WaiterError); !ok {
+							t.Fatalf("expected WaiterError, got %v", err)
+						}
+					case s.delete:
+						if err == nil || !strings.Contains(err.Error(), "deleted") {
+							t.Fatalf("expected deleted error, got %v", err)
+						}
+					default:
+						if err != nil {
+							t.Fatalf("unexpected error: %s", err)
+						}
+					}
+					return
+				case <-tick.C:
+					switch {
+					case s.timeout:
+						continue
+					case s.delete:
+						rs.Create(context.Background(), newTestWidget(false), v1.CreateOptions{})
+						rs.Delete(context.Background(), "test", v1.DeleteOptions{})
+					default:
+						rs.Update(context.Background(), newTestWidget(true), v1.UpdateOptions{})
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestResourceReadiness(t *testing.T) {
+	condition := func(ctype, status string) interface{} {
+		return map[string]interface{}{
+			"type":    ctype,
+			"status":  status,
+			"reason":  "TestReason",
+			"message": "test message",
+		}
+	}
+
+	samples := map[string]struct {
+		generation int64
+		status     map[string]interface{}
+		ready      bool
+		stalled    bool
+	}{
+		"no status": {
+			generation: 1,
+			ready:      true,
+		},
+		"observed generation behind": {
+			generation: 2,
+			status: map[string]interface{}{
+				"observedGeneration": int64(1),
+				"conditions":         []interface{}{condition("Ready", "True")},
+			},
+		},
+		"ready": {
+			generation: 2,
+			status: map[string]interface{}{
+				"observedGeneration": int64(2),
+				"conditions":         []interface{}{condition("Ready", "True")},
+			},
+			ready: true,
+		},
+		"not ready": {
+			generation: 1,
+			status: map[string]interface{}{
+				"conditions": []interface{}{condition("Ready", "False")},
+			},
+		},
+		"reconciling": {
+			generation: 1,
+			status: map[string]interface{}{
+				"conditions": []interface{}{condition("Reconciling", "True"), condition("Ready", "True")},
+			},
+		},
+		"stalled": {
+			generation: 1,
+			status: map[string]interface{}{
+				"conditions": []interface{}{condition("Stalled", "True")},
+			},
+			stalled: true,
+		},
+		"no ready condition": {
+			generation: 1,
+			status: map[string]interface{}{
+				"conditions": []interface{}{condition("Available", "False"), condition("Stalled", "False")},
+			},
+			ready: true,
+		},
+	}
+
+	for name, s := range samples {
+		t.Run(name, func(t *testing.T) {
+			res := newTestWidget(false)
+			res.SetGeneration(s.generation)
+			if s.status != nil {
+				res.Object["status"] = s.status
+			} else {
+				delete(res.Object, "status")
+			}
+			ready, _, err := resourceReadiness(res)
+			if s.stalled {
+				if err == nil || !strings.Contains(err.Error(), "test message") {
+					t.Fatalf("expected stalled error with condition message, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if ready != s.ready {
+				t.Fatalf("expected ready to be %t, got %t", s.ready, ready)
+			}
+		})
+	}
+}
+
+func TestFailureMatcher(t *testing.T) {
+	conditionType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"type":   tftypes.String,
+		"status": tftypes.String,
+		"reason": tftypes.String,
+	}}
+	newCondition := func(ctype, status, reason interface{}) tftypes.Value {
+		return tftypes.NewValue(conditionType, map[string]tftypes.Value{
+			"type":   tftypes.NewValue(tftypes.String, ctype),
+			"status": tftypes.NewValue(tftypes.String, status),
+			"reason": tftypes.NewValue(tftypes.String, reason),
+		})
+	}
+	waitBlockType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"failure_condition": tftypes.List{ElementType: conditionType},
+		"failure_fields":    tftypes.Map{ElementType: tftypes.String},
+	}}
+
+	res := newTestWidget(false)
+	res.Object["status"] = map[string]interface{}{
+		"phase": "Failed",
+		"conditions": []interface{}{
+			map[string]interface{}{
+				"type":    "Ready",
+				"status":  "False",
+				"reason":  "ImagePullBackOff",
+				"message": "image not found",
+			},
+		},
+	}
+	resType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"apiVersion": tftypes.String,
+		"kind":       tftypes.String,
+		"metadata": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"name":      tftypes.String,
+			"namespace": tftypes.String,
+		}},
+		"status": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"phase":      tftypes.String,
+			"conditions": tftypes.DynamicPseudoType,
+		}},
+	}}
+
+	samples := map[string]struct {
+		conditions []tftypes.Value
+		fields     map[string]tftypes.Value
+		failed     bool
+	}{
+		"no match": {
+			conditions: []tftypes.Value{newCondition("Failed", "True", nil)},
+			fields:     map[string]tftypes.Value{"status.phase": tftypes.NewValue(tftypes.String, "Error")},
+		},
+		"condition status": {
+			conditions: []tftypes.Value{newCondition("Ready", "False", nil)},
+			failed:     true,
+		},
+		"condition reason": {
+			conditions: []tftypes.Value{newCondition("Ready", "False", "ImagePullBackOff")},
+			failed:     true,
+		},
+		"condition other reason": {
+			conditions: []tftypes.Value{newCondition("Ready", "False", "Pending")},
+		},
+		"field": {
+			fields: map[string]tftypes.Value{"status.phase": tftypes.NewValue(tftypes.String, "Failed|Error")},
+			failed: true,
+		},
+		"absent field": {
+			fields: map[string]tftypes.Value{"status.reason": tftypes.NewValue(tftypes.String, "*")},
+		},
+	}
+
+	for name, s := range samples {
+		t.Run(name, func(t *testing.T) {
+			if s.conditions == nil {
+				s.conditions = []tftypes.Value{}
+			}
+			w := map[string]tftypes.Value{
+				"failure_condition": tftypes.NewValue(waitBlockType.AttributeTypes["failure_condition"], s.conditions),
+				"failure_fields":    tftypes.NewValue(waitBlockType.AttributeTypes["failure_fields"], s.fields),
+			}
+			m, err := NewFailureMatcher(w, resType, map[string]string{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = m.Check(res.DeepCopy())
+			if !s.failed {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if _, ok := err.(WaiterFailureError); !ok {
+				t.Fatalf("expected WaiterFailureError, got %v", err)
+			}
+		})
+	}
+}
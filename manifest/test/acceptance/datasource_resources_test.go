@@ -130,3 +130,88 @@ func TestDataSourceKubernetesResources_DiffTuples(t *testing.T) {
 	// check the data source
 	tfstate.AssertAttributeLen(t, "data.kubernetes_resources.pods.objects", len(podSpecs))
 }
+
+func TestDataSourceKubernetesResources_Pagination(t *testing.T) {
+	ctx := context.Background()
+
+	reattachInfo, err := provider.ServeTest(ctx, hclog.Default(), t)
+	if err != nil {
+		t.Errorf("Failed to create provider instance: %q", err)
+	}
+
+	name := randName()
+	namespace := randName()
+
+	tf := tfhelper.RequireNewWorkingDir(ctx, t)
+	tf.SetReattachInfo(ctx, reattachInfo)
+	defer func() {
+		tf.Destroy(ctx)
+		tf.Close()
+	}()
+
+	k8shelper.CreateNamespace(t, namespace)
+	defer k8shelper.DeleteResource(t, namespace, kubernetes.NewGroupVersionResource("v1", "namespaces"))
+
+	// the namespace also holds the kube-root-ca.crt ConfigMap
+	for i := 0; i < 4; i++ {
+		k8shelper.CreateConfigMap(t, fmt.Sprintf("%s-%d", name, i), namespace, map[string]interface{}{"foo": "bar"})
+	}
+
+	tfvars := TFVARS{
+		"namespace": namespace,
+	}
+	tfconfig := loadTerraformConfig(t, "DataSourceResources/config_maps_paged.tf", tfvars)
+	tf.SetConfig(ctx, tfconfig)
+	tf.Init(ctx)
+	err = tf.Apply(ctx)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	st, err := tf.State(ctx)
+	if err != nil {
+		t.Fatalf("Failed to retrieve terraform state: %q", err)
+	}
+	tfstate := tfstatehelper.NewHelper(st)
+
+	tfstate.AssertAttributeLen(t, "data.kubernetes_resources.all.objects", 5)
+	tfstate.AssertAttributeDoesNotExist(t, "data.kubernetes_resources.all.objects.0.data")
+	tfstate.AssertAttributeNotEmpty(t, "data.kubernetes_resources.all.objects.0.metadata.name")
+	tfstate.AssertAttributeNotEmpty(t, "data.kubernetes_resources.all.resource_version")
+	tfstate.AssertAttributeLen(t, "data.kubernetes_resources.limited.objects", 3)
+}
+
+func TestDataSourceKubernetesResources_Select(t *testing.T) {
+	ctx := context.Background()
+
+	reattachInfo, err := provider.ServeTest(ctx, hclog.Default(), t)
+	if err != nil {
+		t.Errorf("Failed to create provider instance: %q", err)
+	}
+
+	tf := tfhelper.RequireNewWorkingDir(ctx, t)
+	tf.SetReattachInfo(ctx, reattachInfo)
+	defer func() {
+		tf.Destroy(ctx)
+		tf.Close()
+	}()
+
+	tfconfig := loadTerraformConfig(t, "DataSourceResources/nodes_select.tf", TFVARS{})
+	tf.SetConfig(ctx, tfconfig)
+	tf.Init(ctx)
+	err = tf.Apply(ctx)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	st, err := tf.State(ctx)
+	if err != nil {
+		t.Fatalf("Failed to retrieve terraform state: %q", err)
+	}
+	tfstate := tfstatehelper.NewHelper(st)
+
+	tfstate.AssertAttributeNotEmpty(t, "data.kubernetes_resources.nodes.items.0.name")
+	tfstate.AssertAttributeNotEmpty(t, "data.kubernetes_resources.nodes.items.0.internal_ip")
+	tfstate.AssertAttributeNotEmpty(t, "data.kubernetes_resources.nodes.items.0.pods")
+	tfstate.AssertAttributeDoesNotExist(t, "data.kubernetes_resources.nodes.objects.0")
+}
@@ -112,3 +112,76 @@ func TestKubernetesManifest_fieldManager(t *testing.T) {
 		"kubernetes_manifest.test.field_manager.0.force_conflicts": true,
 	})
 }
+
+func TestKubernetesManifest_fieldManagerAdoptAndRelease(t *testing.T) {
+	ctx := context.Background()
+
+	reattachInfo, err := provider.ServeTest(ctx, hclog.Default(), t)
+	if err != nil {
+		t.Errorf("Failed to create provider instance: %q", err)
+	}
+
+	name := randName()
+	namespace := randName()
+
+	tf := tfhelper.RequireNewWorkingDir(ctx, t)
+	tf.SetReattachInfo(ctx, reattachInfo)
+	defer tf.Close()
+
+	k8shelper.CreateNamespace(t, namespace)
+	defer k8shelper.DeleteResource(t, namespace, kubernetes.NewGroupVersionResource("v1", "namespaces"))
+
+	// 1. Create the resource
+	tfvars := TFVARS{
+		"namespace":     namespace,
+		"name":          name,
+		"field_manager": "tftest",
+		"adopt_from":    "kubectl-client-side-apply",
+		"release":       false,
+		"data":          "bar",
+	}
+	tfconfig := loadTerraformConfig(t, "FieldManager/adopt.tf", tfvars)
+	tf.SetConfig(ctx, tfconfig)
+	tf.Init(ctx)
+	err = tf.Apply(ctx)
+	if err != nil {
+		t.Fatalf("Failed to apply: %q", err)
+	}
+
+	// 2. Change the resource the way kubectl client-side apply would, taking over the field
+	k8shelper.PatchNamespacedResource(t, "v1", "configmaps", namespace, name, "kubectl-client-side-apply", []byte(`{"data":{"foo":"baz"}}`))
+
+	// 3. Changing the field again only succeeds without a conflict because its ownership is adopted
+	tfvars["data"] = "foobar"
+	tfconfig = loadTerraformConfig(t, "FieldManager/adopt.tf", tfvars)
+	tf.SetConfig(ctx, tfconfig)
+	err = tf.Apply(ctx)
+	if err != nil {
+		t.Fatalf("Failed to apply: %q", err)
+	}
+
+	s, err := tf.State(ctx)
+	if err != nil {
+		t.Fatalf("Failed to retrieve terraform state: %q", err)
+	}
+	tfstate := tfstatehelper.NewHelper(s)
+	tfstate.AssertAttributeValues(t, tfstatehelper.AttributeValues{
+		"kubernetes_manifest.test.object.data.foo": "foobar",
+	})
+
+	// 4. Destroying releases the resource instead of deleting it
+	tfvars["release"] = true
+	tfconfig = loadTerraformConfig(t, "FieldManager/adopt.tf", tfvars)
+	tf.SetConfig(ctx, tfconfig)
+	err = tf.Apply(ctx)
+	if err != nil {
+		t.Fatalf("Failed to apply: %q", err)
+	}
+	err = tf.Destroy(ctx)
+	if err != nil {
+		t.Fatalf("Failed to destroy: %q", err)
+	}
+
+	k8shelper.AssertNamespacedResourceExists(t, "v1", "configmaps", namespace, name)
+	k8shelper.AssertNamespacedResourceField(t, "v1", "configmaps", namespace, name, []string{"data", "foo"}, "foobar")
+}
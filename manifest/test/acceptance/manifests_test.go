@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build acceptance
+// +build acceptance
+
+package acceptance
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest/provider"
+	tfstatehelper "github.com/hashicorp/terraform-provider-kubernetes/manifest/test/helper/state"
+)
+
+func TestKubernetesManifests_Bundle(t *testing.T) {
+	ctx := context.Background()
+
+	reattachInfo, err := provider.ServeTest(ctx, hclog.Default(), t)
+	if err != nil {
+		t.Errorf("Failed to create provider instance: %q", err)
+	}
+
+	name := randName()
+	namespace := randName()
+
+	tf := tfhelper.RequireNewWorkingDir(ctx, t)
+	tf.SetReattachInfo(ctx, reattachInfo)
+	defer func() {
+		tf.Destroy(ctx)
+		tf.Close()
+		k8shelper.AssertNamespacedResourceDoesNotExist(t, "v1", "services", namespace, name)
+	}()
+
+	tfvars := TFVARS{
+		"namespace":          namespace,
+		"name":               name,
+		"include_config_map": true,
+	}
+	tfconfig := loadTerraformConfig(t, "Manifests/bundle.tf", tfvars)
+	tf.SetConfig(ctx, tfconfig)
+	tf.Init(ctx)
+	err = tf.Apply(ctx)
+	if err != nil {
+		t.Fatalf("Failed to apply: %q", err)
+	}
+
+	// the namespace is applied before the objects it contains
+	k8shelper.AssertResourceExists(t, "v1", "namespaces", namespace)
+	k8shelper.AssertNamespacedResourceExists(t, "v1", "services", namespace, name)
+	k8shelper.AssertNamespacedResourceExists(t, "v1", "configmaps", namespace, name+"-config")
+
+	s, err := tf.State(ctx)
+	if err != nil {
+		t.Fatalf("Failed to retrieve terraform state: %q", err)
+	}
+	tfstate := tfstatehelper.NewHelper(s)
+	tfstate.AssertAttributeLen(t, "kubernetes_manifests.test.manifests", 3)
+	tfstate.AssertAttributeExists(t, "kubernetes_manifests.test.manifests.v1/Namespace/"+namespace)
+	tfstate.AssertAttributeNotEmpty(t, "kubernetes_manifests.test.inventory_id")
+
+	// removing the config map from the bundle prunes it
+	tfvars["include_config_map"] = false
+	tfconfig = loadTerraformConfig(t, "Manifests/bundle.tf", tfvars)
+	tf.SetConfig(ctx, tfconfig)
+	err = tf.Apply(ctx)
+	if err != nil {
+		t.Fatalf("Failed to apply: %q", err)
+	}
+
+	k8shelper.AssertNamespacedResourceDoesNotExist(t, "v1", "configmaps", namespace, name+"-config")
+	k8shelper.AssertNamespacedResourceExists(t, "v1", "services", namespace, name)
+
+	s, err = tf.State(ctx)
+	if err != nil {
+		t.Fatalf("Failed to retrieve terraform state: %q", err)
+	}
+	tfstate = tfstatehelper.NewHelper(s)
+	tfstate.AssertAttributeLen(t, "kubernetes_manifests.test.manifests", 2)
+}
+
+func TestKubernetesManifests_ApplySet(t *testing.T) {
+	ctx := context.Background()
+
+	reattachInfo, err := provider.ServeTest(ctx, hclog.Default(), t)
+	if err != nil {
+		t.Errorf("Failed to create provider instance: %q", err)
+	}
+
+	name := randName()
+	namespace := randName()
+
+	tf := tfhelper.RequireNewWorkingDir(ctx, t)
+	tf.SetReattachInfo(ctx, reattachInfo)
+	defer func() {
+		tf.Destroy(ctx)
+		tf.Close()
+		k8shelper.AssertNamespacedResourceDoesNotExist(t, "v1", "secrets", "default", name)
+	}()
+
+	tfvars := TFVARS{
+		"namespace":          namespace,
+		"name":               name,
+		"include_config_map": true,
+	}
+	tfconfig := loadTerraformConfig(t, "Manifests/applyset.tf", tfvars)
+	tf.SetConfig(ctx, tfconfig)
+	tf.Init(ctx)
+	err = tf.Apply(ctx)
+	if err != nil {
+		t.Fatalf("Failed to apply: %q", err)
+	}
+
+	k8shelper.AssertNamespacedResourceExists(t, "v1", "secrets", "default", name)
+	k8shelper.AssertNamespacedResourceExists(t, "v1", "configmaps", namespace, name+"-config")
+
+	s, err := tf.State(ctx)
+	if err != nil {
+		t.Fatalf("Failed to retrieve terraform state: %q", err)
+	}
+	tfstate := tfstatehelper.NewHelper(s)
+	tfstate.AssertAttributeLen(t, "kubernetes_manifests.test.manifests", 2)
+
+	// objects carrying the ApplySet label are pruned even when they are missing from the state,
+	// such as those left behind by a failed apply
+	k8shelper.CreateConfigMap(t, name+"-stray", namespace, map[string]interface{}{"foo": "bar"})
+	k8shelper.PatchNamespacedResource(t, "v1", "configmaps", namespace, name+"-stray", "tf-acc-test",
+		[]byte(`{"metadata":{"labels":{"applyset.kubernetes.io/part-of":"`+applySetID(name, "default", "Secret")+`"}}}`))
+
+	// removing the config map from the bundle prunes it along with the stray member
+	tfvars["include_config_map"] = false
+	tfconfig = loadTerraformConfig(t, "Manifests/applyset.tf", tfvars)
+	tf.SetConfig(ctx, tfconfig)
+	err = tf.Apply(ctx)
+	if err != nil {
+		t.Fatalf("Failed to apply: %q", err)
+	}
+
+	k8shelper.AssertNamespacedResourceDoesNotExist(t, "v1", "configmaps", namespace, name+"-config")
+	k8shelper.AssertNamespacedResourceDoesNotExist(t, "v1", "configmaps", namespace, name+"-stray")
+	k8shelper.AssertNamespacedResourceExists(t, "v1", "secrets", "default", name)
+}
+
+// applySetID returns the ID kubectl derives for the ApplySet of the given parent object
+func applySetID(name, namespace, kind string) string {
+	hashed := sha256.Sum256([]byte(strings.Join([]string{name, namespace, kind, ""}, ".")))
+	return fmt.Sprintf("applyset-%s-v1", base64.RawURLEncoding.EncodeToString(hashed[:]))
+}
@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "kubernetes_resource_table" "config_maps" {
+  kind        = "ConfigMap"
+  api_version = "v1"
+  namespace   = var.namespace
+
+  field_selector = "metadata.name=${var.name}"
+}
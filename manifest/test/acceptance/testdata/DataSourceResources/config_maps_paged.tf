@@ -0,0 +1,19 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "kubernetes_resources" "all" {
+  kind           = "ConfigMap"
+  api_version    = "v1"
+  all_namespaces = true
+  field_selector = "metadata.namespace=${var.namespace}"
+  page_size      = 2
+  metadata_only  = true
+}
+
+data "kubernetes_resources" "limited" {
+  kind        = "ConfigMap"
+  api_version = "v1"
+  namespace   = var.namespace
+  page_size   = 2
+  limit       = 3
+}
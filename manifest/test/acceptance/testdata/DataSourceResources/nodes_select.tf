@@ -0,0 +1,13 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "kubernetes_resources" "nodes" {
+  kind        = "Node"
+  api_version = "v1"
+  filter      = "self.status.conditions.exists(c, c.type == 'Ready' && c.status == 'True')"
+  select = {
+    name        = "{.metadata.name}"
+    internal_ip = "{.status.addresses[?(@.type==\"InternalIP\")].address}"
+    pods        = "int(self.status.capacity.pods)"
+  }
+}
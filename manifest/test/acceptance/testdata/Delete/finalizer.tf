@@ -0,0 +1,26 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    apiVersion = "v1"
+    kind       = "ConfigMap"
+    metadata = {
+      name      = var.name
+      namespace = var.namespace
+      finalizers = [
+        "terraform.io/test-finalizer",
+      ]
+    }
+    data = {
+      foo = "bar"
+    }
+  }
+
+  delete_propagation           = "Foreground"
+  remove_finalizers_on_timeout = var.remove_finalizers
+
+  timeouts {
+    delete = "5s"
+  }
+}
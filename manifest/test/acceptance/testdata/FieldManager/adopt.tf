@@ -0,0 +1,22 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    apiVersion = "v1"
+    kind       = "ConfigMap"
+    metadata = {
+      name      = var.name
+      namespace = var.namespace
+    }
+    data = {
+      foo = var.data
+    }
+  }
+
+  field_manager {
+    name                = var.field_manager
+    adopt_from_managers = [var.adopt_from]
+    release_on_destroy  = var.release
+  }
+}
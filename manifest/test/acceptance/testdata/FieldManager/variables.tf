@@ -29,3 +29,11 @@ variable "force" {
 variable "data" {
   type = string
 }
+
+variable "adopt_from" {
+  type = string
+}
+
+variable "release" {
+  type = bool
+}
@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+locals {
+  config_map = <<-EOT
+    ---
+    apiVersion: v1
+    kind: ConfigMap
+    metadata:
+      name: ${var.name}-config
+      namespace: ${var.namespace}
+    data:
+      foo: bar
+  EOT
+}
+
+resource "kubernetes_manifests" "test" {
+  content = <<-EOT
+    apiVersion: v1
+    kind: Namespace
+    metadata:
+      name: ${var.namespace}
+    ${var.include_config_map ? local.config_map : ""}
+  EOT
+
+  applyset {
+    name      = var.name
+    namespace = "default"
+  }
+}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"encoding/json"
 	"log"
+	"strings"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -19,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
@@ -161,6 +163,17 @@ func (k *Helper) DeleteNamespacedResource(t *testing.T, name string, namespace s
 	}
 }
 
+// PatchNamespacedResource applies a JSON merge patch to a namespaced resource on behalf of the given field manager
+func (k *Helper) PatchNamespacedResource(t *testing.T, gv, resource, namespace, name, fieldManager string, patch []byte) {
+	t.Helper()
+
+	gvr := NewGroupVersionResource(gv, resource)
+	_, err := k.dynClient.Resource(gvr).Namespace(namespace).Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: fieldManager})
+	if err != nil {
+		t.Fatalf("Failed to patch resource \"%s/%s\": %v", namespace, name, err)
+	}
+}
+
 func NewGroupVersionResource(gv, resource string) schema.GroupVersionResource {
 	gvr, _ := schema.ParseGroupVersion(gv)
 	return gvr.WithResource(resource)
@@ -253,6 +266,36 @@ func (k *Helper) AssertResourceGeneration(t *testing.T, gv, resource, namespace,
 
 }
 
+// AssertNamespacedResourceField will fail if the field at the given path of the resource does not have the expected value
+func (k *Helper) AssertNamespacedResourceField(t *testing.T, gv, resource, namespace, name string, path []string, value string) {
+	t.Helper()
+
+	gvr := NewGroupVersionResource(gv, resource)
+
+	op := func() error {
+		res, operr := k.dynClient.Resource(gvr).Namespace(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if operr != nil {
+			return operr
+		}
+
+		v, _, _ := unstructured.NestedString(res.Object, path...)
+		if v != value {
+			t.Errorf("Expected %s to be %q actual %q", strings.Join(path, "."), value, v)
+		}
+		return nil
+	}
+	err := k8sretry.OnError(k8sretry.DefaultBackoff, func(e error) bool {
+		if !isErrorRetriable(e) {
+			t.Logf("Error not retriable: %s", e)
+			return false
+		}
+		return !errors.IsNotFound(e)
+	}, op)
+	if err != nil {
+		t.Errorf("Error when trying to get resource %s/%s: %v", namespace, name, err)
+	}
+}
+
 // AssertNamespacedResourceDoesNotExist fails the test if the resource still exists in the namespace specified
 func (k *Helper) AssertNamespacedResourceDoesNotExist(t *testing.T, gv, resource, namespace, name string) {
 	t.Helper()